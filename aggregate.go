@@ -0,0 +1,88 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// numericCodes maps ISO 4217 numeric codes, below 1000, to currency codes
+var numericCodes struct {
+	sync.Once
+	codes [1000]string
+}
+
+func indexNumericCodes() {
+	for code, c := range currencies {
+		if c.IsoNumeric > 0 && c.IsoNumeric < len(numericCodes.codes) {
+			numericCodes.codes[c.IsoNumeric] = code
+		}
+	}
+}
+
+// NumericCode returns the ISO 4217 numeric code of the currency, failing for
+// currencies without one, such as BTC
+func NumericCode(code string) (uint16, error) {
+	c, ok := currencies[strings.ToUpper(code)]
+
+	if !ok {
+		return 0, fmt.Errorf("%w %q", ErrUnknownCurrency, code)
+	}
+
+	if c.IsoNumeric <= 0 {
+		return 0, fmt.Errorf("money: currency %s has no numeric code", strings.ToUpper(code))
+	}
+
+	return uint16(c.IsoNumeric), nil
+}
+
+// CurrencyByNumeric returns the ISO code of the currency with the numeric code
+func CurrencyByNumeric(n uint16) (string, error) {
+	numericCodes.Do(indexNumericCodes)
+
+	if int(n) >= len(numericCodes.codes) || numericCodes.codes[n] == "" {
+		return "", fmt.Errorf("%w numeric code %d", ErrUnknownCurrency, n)
+	}
+
+	return numericCodes.codes[n], nil
+}
+
+// AggregateByCurrency sums amounts in minor units partitioned by the ISO 4217
+// numeric currency code at the same index of codes, for ETL jobs holding amounts
+// and currencies in parallel columns. TotalByCurrency is the Money equivalent.
+func AggregateByCurrency(amounts []int64, codes []uint16) (map[uint16]int64, error) {
+	if len(amounts) != len(codes) {
+		return nil, fmt.Errorf("money: %d amounts but %d currency codes", len(amounts), len(codes))
+	}
+
+	numericCodes.Do(indexNumericCodes)
+
+	var sums [len(numericCodes.codes)]int64
+	var seen [len(numericCodes.codes)]bool
+
+	for i, a := range amounts {
+		n := codes[i]
+
+		if int(n) >= len(sums) || numericCodes.codes[n] == "" {
+			return nil, fmt.Errorf("%w numeric code %d at index %d", ErrUnknownCurrency, n, i)
+		}
+
+		s := sums[n] + a
+
+		if (s > sums[n]) != (a > 0) {
+			return nil, fmt.Errorf("%w: sum of %s amounts", ErrOverflow, numericCodes.codes[n])
+		}
+
+		sums[n], seen[n] = s, true
+	}
+
+	totals := map[uint16]int64{}
+
+	for n, ok := range seen {
+		if ok {
+			totals[uint16(n)] = sums[n]
+		}
+	}
+
+	return totals, nil
+}
@@ -0,0 +1,64 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestAggregateByCurrency(t *testing.T) {
+	usd, _ := NumericCode("usd")
+	eur, _ := NumericCode("EUR")
+
+	totals, err := AggregateByCurrency([]int64{100, 250, -50, 7}, []uint16{usd, eur, usd, eur})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(totals) != 2 || totals[usd] != 50 || totals[eur] != 257 {
+		t.Errorf("Expected 50 USD and 257 EUR but got %v", totals)
+	}
+
+	if totals, _ := AggregateByCurrency(nil, nil); len(totals) != 0 {
+		t.Errorf("Expected no totals but got %v", totals)
+	}
+}
+
+func TestAggregateByCurrencyWhenInvalid(t *testing.T) {
+	if _, err := AggregateByCurrency([]int64{1, 2}, []uint16{840}); err == nil {
+		t.Error("Expected columns of different lengths to fail")
+	}
+
+	for _, n := range []uint16{0, 1, 1000, math.MaxUint16} {
+		if _, err := AggregateByCurrency([]int64{1}, []uint16{n}); !errors.Is(err, ErrUnknownCurrency) {
+			t.Errorf("Expected ErrUnknownCurrency for %d but got %v", n, err)
+		}
+	}
+
+	if _, err := AggregateByCurrency([]int64{math.MaxInt64, 1}, []uint16{840, 840}); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow but got %v", err)
+	}
+}
+
+func TestNumericCode(t *testing.T) {
+	if n, err := NumericCode("usd"); err != nil || n != 840 {
+		t.Errorf("Expected 840 but got %d %v", n, err)
+	}
+
+	if _, err := NumericCode("BTC"); err == nil {
+		t.Error("Expected BTC to have no numeric code")
+	}
+
+	if _, err := NumericCode("XYZ"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+
+	if code, err := CurrencyByNumeric(978); err != nil || code != "EUR" {
+		t.Errorf("Expected EUR but got %s %v", code, err)
+	}
+
+	if _, err := CurrencyByNumeric(0); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+}
@@ -0,0 +1,466 @@
+package money
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Money is an exact amount held in the smallest unit of its currency, e.g. cents
+type Money struct {
+	amount   int64
+	currency string
+}
+
+// FromMinorUnits returns the Money worth amount minor units of the currency,
+// e.g. FromMinorUnits(1050, "usd") is $10.50
+func FromMinorUnits(amount int64, code string) (Money, error) {
+	code = strings.ToUpper(code)
+
+	if _, ok := currencies[code]; !ok {
+		return Money{}, fmt.Errorf("%w %q", ErrUnknownCurrency, code)
+	}
+
+	return Money{amount, code}, nil
+}
+
+// FromFloat returns the Money closest to val in the currency, rounding half away
+// from zero on the shortest decimal representation of val, so 1.005 is 1.01.
+// SetStrictFloats can make such rounding fail or be reported.
+func FromFloat(val float64, code string) (Money, error) {
+	m, err := roundFloat(val, code)
+
+	if err != nil {
+		return Money{}, err
+	}
+
+	if excessDigits(val, m.currency) != nil {
+		if err := inexactFloat(val, m); err != nil {
+			return Money{}, err
+		}
+	}
+
+	return m, nil
+}
+
+// roundFloat is FromFloat without the SetStrictFloats checks
+func roundFloat(val float64, code string) (Money, error) {
+	code = strings.ToUpper(code)
+	c, ok := currencies[code]
+
+	if !ok {
+		return Money{}, fmt.Errorf("%w %q", ErrUnknownCurrency, code)
+	}
+
+	if math.IsNaN(val) || math.IsInf(val, 0) {
+		return Money{}, fmt.Errorf("money: invalid amount %v", val)
+	}
+
+	digits := c.exponent()
+	text := strconv.FormatFloat(math.Abs(val), 'f', -1, 64)
+	decimals, err := parseUnits(roundHalfUp(text, digits), digits)
+
+	if err != nil {
+		return Money{}, fmt.Errorf("%w: %v %s", ErrOverflow, val, code)
+	}
+
+	amount, err := decimalsToUnits(decimals, digits, c.units())
+
+	if err != nil {
+		return Money{}, fmt.Errorf("%w: %v %s", err, val, code)
+	}
+
+	if val < 0 {
+		amount = -amount
+	}
+
+	return Money{amount, code}, nil
+}
+
+// MinorUnits returns the amount in the smallest unit of the currency
+func (m Money) MinorUnits() int64 {
+	return m.amount
+}
+
+// Currency returns the ISO code of the currency
+func (m Money) Currency() string {
+	return m.currency
+}
+
+// Float returns the amount in major units, which may not be exact
+func (m Money) Float() float64 {
+	return float64(m.amount) / float64(currencies[m.currency].units())
+}
+
+// Add returns m + o, failing when currencies differ or the result overflows
+func (m Money) Add(o Money) (Money, error) {
+	if err := m.sameCurrency(o); err != nil {
+		return Money{}, err
+	}
+
+	if o.amount > 0 && m.amount > math.MaxInt64-o.amount || o.amount < 0 && m.amount < math.MinInt64-o.amount {
+		return Money{}, fmt.Errorf("%w: %s + %s", ErrOverflow, m, o)
+	}
+
+	return Money{m.amount + o.amount, m.currency}, nil
+}
+
+// Subtract returns m - o, failing when currencies differ or the result overflows
+func (m Money) Subtract(o Money) (Money, error) {
+	if err := m.sameCurrency(o); err != nil {
+		return Money{}, err
+	}
+
+	if o.amount < 0 && m.amount > math.MaxInt64+o.amount || o.amount > 0 && m.amount < math.MinInt64+o.amount {
+		return Money{}, fmt.Errorf("%w: %s - %s", ErrOverflow, m, o)
+	}
+
+	return Money{m.amount - o.amount, m.currency}, nil
+}
+
+// Multiply returns m * n, failing when the result overflows
+func (m Money) Multiply(n int64) (Money, error) {
+	product := new(big.Int).Mul(big.NewInt(m.amount), big.NewInt(n))
+
+	if !product.IsInt64() {
+		return Money{}, fmt.Errorf("%w: %s * %d", ErrOverflow, m, n)
+	}
+
+	return Money{product.Int64(), m.currency}, nil
+}
+
+// Split divides m into n parts differing by at most one minor unit, handing the
+// remainder to the first parts so that the parts always add up to m
+func (m Money) Split(n int) ([]Money, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("money: cannot split into %d parts", n)
+	}
+
+	ratios := make([]int, n)
+
+	for i := range ratios {
+		ratios[i] = 1
+	}
+
+	return m.Allocate(ratios...)
+}
+
+// Allocate divides m proportionally to ratios, handing the remainder one minor unit
+// at a time to the first parts with a non zero ratio, so the parts add up to m
+func (m Money) Allocate(ratios ...int) ([]Money, error) {
+	parts, _, err := m.allocate(ratios, 0)
+	return parts, err
+}
+
+// allocate is Allocate handing the remainder out from part start on, wrapping
+// around, and also returns the index after the last part handed a unit
+func (m Money) allocate(ratios []int, start int) ([]Money, int, error) {
+	total := new(big.Int)
+
+	for _, r := range ratios {
+		if r < 0 {
+			return nil, 0, fmt.Errorf("money: cannot allocate with negative ratio %d", r)
+		}
+
+		total.Add(total, big.NewInt(int64(r)))
+	}
+
+	if total.Sign() == 0 {
+		return nil, 0, fmt.Errorf("money: cannot allocate without a positive ratio")
+	}
+
+	amount := big.NewInt(m.amount)
+	parts := make([]Money, len(ratios))
+	remainder := m.amount
+
+	for i, r := range ratios {
+		share := new(big.Int).Mul(amount, big.NewInt(int64(r)))
+		share.Quo(share, total)
+
+		parts[i] = Money{share.Int64(), m.currency}
+		remainder -= parts[i].amount
+	}
+
+	unit := int64(1)
+
+	if remainder < 0 {
+		unit = -1
+	}
+
+	next := start
+
+	for i := start; remainder != 0; i++ {
+		k := i % len(ratios)
+
+		if ratios[k] == 0 {
+			continue
+		}
+
+		parts[k].amount += unit
+		remainder -= unit
+		next = (k + 1) % len(ratios)
+	}
+
+	return parts, next, nil
+}
+
+// Equals reports whether m and o have the same currency and amount
+func (m Money) Equals(o Money) bool {
+	return m == o
+}
+
+// Compare returns -1, 0 or 1 as m is less than, equal to or greater than o,
+// failing when currencies differ
+func (m Money) Compare(o Money) (int, error) {
+	if err := m.sameCurrency(o); err != nil {
+		return 0, err
+	}
+
+	switch {
+	case m.amount < o.amount:
+		return -1, nil
+	case m.amount > o.amount:
+		return 1, nil
+	}
+
+	return 0, nil
+}
+
+// GreaterThan reports whether m > o, failing when currencies differ
+func (m Money) GreaterThan(o Money) (bool, error) {
+	c, err := m.Compare(o)
+	return c > 0, err
+}
+
+// GreaterThanOrEqual reports whether m >= o, failing when currencies differ
+func (m Money) GreaterThanOrEqual(o Money) (bool, error) {
+	c, err := m.Compare(o)
+	return c >= 0 && err == nil, err
+}
+
+// LessThan reports whether m < o, failing when currencies differ
+func (m Money) LessThan(o Money) (bool, error) {
+	c, err := m.Compare(o)
+	return c < 0, err
+}
+
+// LessThanOrEqual reports whether m <= o, failing when currencies differ
+func (m Money) LessThanOrEqual(o Money) (bool, error) {
+	c, err := m.Compare(o)
+	return c <= 0 && err == nil, err
+}
+
+// IsZero reports whether the amount is zero
+func (m Money) IsZero() bool {
+	return m.amount == 0
+}
+
+// IsPositive reports whether the amount is greater than zero
+func (m Money) IsPositive() bool {
+	return m.amount > 0
+}
+
+// IsNegative reports whether the amount is less than zero
+func (m Money) IsNegative() bool {
+	return m.amount < 0
+}
+
+// Format returns a formatted price string according to the currency rules and
+// options, as Format does, showing every digit of the currency sub unit.
+// The "currency" option is ignored in favor of the currency of m.
+func (m Money) Format(opts ...Options) (result string) {
+	defer guard(nil)
+
+	options := defaults()
+
+	if len(opts) > 0 {
+		noteDeprecated(opts[0])
+		options = override(options, opts[0])
+	}
+
+	options["currency"] = m.currency
+
+	if fn, ok := lookupMoneyFormat(options["format"].(string)); ok {
+		options["format"] = ""
+		return fn(m, options)
+	}
+
+	return string(cachedLayout(options).appendUnits(nil, m.amount))
+}
+
+// String returns the amount formatted with default options
+func (m Money) String() string {
+	return m.Format()
+}
+
+// moneyJSON is the JSON encoding of Money
+type moneyJSON struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// MarshalJSON encodes m as its minor units and currency code, e.g.
+// {"amount":1050,"currency":"USD"} for $10.50
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(moneyJSON{m.amount, m.currency})
+}
+
+// UnmarshalJSON decodes Money encoded by MarshalJSON, failing for unknown
+// currencies. The encoding of the zero Money decodes to the zero Money.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var v moneyJSON
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	if v == (moneyJSON{}) {
+		*m = Money{}
+		return nil
+	}
+
+	decoded, err := FromMinorUnits(v.Amount, v.Currency)
+
+	if err != nil {
+		return err
+	}
+
+	*m = decoded
+
+	return nil
+}
+
+// sameCurrency fails with ErrCurrencyMismatch when o is in another currency
+func (m Money) sameCurrency(o Money) error {
+	if m.currency != o.currency {
+		return fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.currency, o.currency)
+	}
+
+	return nil
+}
+
+// mulRate returns m * rate rounded half away from zero to minor units, using the
+// shortest decimal representation of rate so the product is exact before rounding
+func (m Money) mulRate(rate float64) (Money, error) {
+	if math.IsNaN(rate) || math.IsInf(rate, 0) {
+		return Money{}, fmt.Errorf("money: invalid rate %v", rate)
+	}
+
+	r := decimalRat(rate)
+	product := r.Mul(r, new(big.Rat).SetInt64(m.amount))
+
+	units, ok := roundRat(product)
+
+	if !ok {
+		return Money{}, fmt.Errorf("%w: %s * %v", ErrOverflow, m, rate)
+	}
+
+	return Money{units, m.currency}, nil
+}
+
+// decimalRat returns the shortest decimal representation of f as a rational
+func decimalRat(f float64) *big.Rat {
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(f, 'f', -1, 64))
+	return r
+}
+
+// roundRat rounds r half away from zero, reporting whether it fits in an int64
+func roundRat(r *big.Rat) (int64, bool) {
+	return roundRatMode(r, HalfUp)
+}
+
+// decimalsToUnits converts an amount counted in 10^-digits to minor units,
+// rounding half away from zero for currencies whose sub unit is not decimal
+func decimalsToUnits(decimals int64, digits int, units int64) (int64, error) {
+	scale := int64(pow10(digits))
+
+	if scale == units {
+		return decimals, nil
+	}
+
+	if decimals > (math.MaxInt64-scale/2)/units {
+		return 0, ErrOverflow
+	}
+
+	return (decimals*units + scale/2) / scale, nil
+}
+
+// parseUnits parses a plain decimal field into minor units of the given exponent
+func parseUnits(field string, digits int) (int64, error) {
+	fail := func(pos int, msg string) (int64, error) {
+		return 0, &ParseError{Input: field, Pos: pos, Msg: msg}
+	}
+
+	s := field
+	negative := strings.HasPrefix(s, "-")
+
+	if negative {
+		s = s[1:]
+	}
+
+	integer, fractional := splitDecimal(s)
+	offset := len(field) - len(s)
+
+	if integer == "" {
+		return fail(offset, "missing integer digits")
+	}
+
+	if strings.HasSuffix(s, ".") {
+		return fail(len(field), "missing fraction digits")
+	}
+
+	if len(fractional) > digits {
+		return fail(offset+len(integer)+1+digits, fmt.Sprintf("more than %d fraction digits", digits))
+	}
+
+	fractional += strings.Repeat("0", digits-len(fractional))
+
+	var units int64
+
+	for i, r := range integer + fractional {
+		if r < '0' || r > '9' {
+			pos := offset + i
+
+			if i >= len(integer) {
+				pos++
+			}
+
+			return fail(pos, fmt.Sprintf("unexpected character %q", r))
+		}
+
+		if units > (math.MaxInt64-int64(r-'0'))/10 {
+			return 0, &ParseError{Input: field, Pos: offset + i, Msg: "amount too large", Err: ErrOverflow}
+		}
+
+		units = units*10 + int64(r-'0')
+	}
+
+	if negative {
+		units = -units
+	}
+
+	return units, nil
+}
+
+// absUnits returns the magnitude of units as an unsigned value, so MinInt64 is safe
+func absUnits(units int64) uint64 {
+	if units < 0 {
+		return uint64(-(units + 1)) + 1
+	}
+
+	return uint64(units)
+}
+
+// pow10 returns 10 to the power of n
+func pow10(n int) uint64 {
+	p := uint64(1)
+
+	for i := 0; i < n; i++ {
+		p *= 10
+	}
+
+	return p
+}
@@ -0,0 +1,302 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestFromMinorUnits(t *testing.T) {
+	m, err := FromMinorUnits(1050, "usd")
+
+	if err != nil {
+		t.Fatalf("Expected money but got %s", err)
+	}
+
+	if m.MinorUnits() != 1050 || m.Currency() != "USD" {
+		t.Errorf("Expected 1050 USD but got %d %s", m.MinorUnits(), m.Currency())
+	}
+
+	if _, err := FromMinorUnits(1050, "xyz"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+}
+
+func TestFromFloat(t *testing.T) {
+	values := []struct {
+		val      float64
+		code     string
+		expected int64
+	}{
+		{10.50, "usd", 1050},
+		{1.005, "USD", 101},
+		{-1.005, "USD", -101},
+		{0.1 + 0.2, "USD", 30},
+		{1234.5, "JPY", 1235},
+		{1.2345, "BHD", 1235},
+		{1.4, "MGA", 7},
+		{1.3, "MGA", 7},
+	}
+
+	for _, v := range values {
+		m, err := FromFloat(v.val, v.code)
+
+		if err != nil {
+			t.Errorf("Expected %v %s to convert but got %s", v.val, v.code, err)
+			continue
+		}
+
+		if m.MinorUnits() != v.expected {
+			t.Errorf("Expected %v %s to be %d minor units but got %d", v.val, v.code, v.expected, m.MinorUnits())
+		}
+	}
+}
+
+func TestFromFloatWhenInvalid(t *testing.T) {
+	if _, err := FromFloat(1e30, "USD"); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow but got %v", err)
+	}
+
+	if _, err := FromFloat(math.NaN(), "USD"); err == nil {
+		t.Error("Expected NaN to be rejected")
+	}
+
+	if _, err := FromFloat(1, "XYZ"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+}
+
+func TestMoneyFloat(t *testing.T) {
+	if f := (Money{1050, "USD"}).Float(); f != 10.5 {
+		t.Errorf("Expected 10.5 but got %v", f)
+	}
+
+	if f := (Money{7, "MGA"}).Float(); f != 1.4 {
+		t.Errorf("Expected 1.4 but got %v", f)
+	}
+}
+
+func TestMoneyAdd(t *testing.T) {
+	m, err := Money{1050, "USD"}.Add(Money{-75, "USD"})
+
+	if err != nil || m != (Money{975, "USD"}) {
+		t.Errorf("Expected 975 USD but got %v %v", m.MinorUnits(), err)
+	}
+
+	if _, err := (Money{1, "USD"}).Add(Money{1, "EUR"}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+
+	if _, err := (Money{math.MaxInt64, "USD"}).Add(Money{1, "USD"}); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow but got %v", err)
+	}
+}
+
+func TestMoneySubtract(t *testing.T) {
+	m, err := Money{1050, "USD"}.Subtract(Money{75, "USD"})
+
+	if err != nil || m != (Money{975, "USD"}) {
+		t.Errorf("Expected 975 USD but got %v %v", m.MinorUnits(), err)
+	}
+
+	if _, err := (Money{1, "USD"}).Subtract(Money{1, "EUR"}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+
+	if _, err := (Money{math.MinInt64, "USD"}).Subtract(Money{1, "USD"}); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow but got %v", err)
+	}
+}
+
+func TestMoneyMultiply(t *testing.T) {
+	m, err := Money{1050, "USD"}.Multiply(-3)
+
+	if err != nil || m != (Money{-3150, "USD"}) {
+		t.Errorf("Expected -3150 USD but got %v %v", m.MinorUnits(), err)
+	}
+
+	if _, err := (Money{math.MaxInt64 / 2, "USD"}).Multiply(3); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow but got %v", err)
+	}
+}
+
+func TestMoneySplit(t *testing.T) {
+	parts, err := Money{100, "USD"}.Split(3)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []int64{34, 33, 33}
+
+	for i, part := range parts {
+		if part.MinorUnits() != expected[i] {
+			t.Errorf("Expected part %d to be %d but got %d", i, expected[i], part.MinorUnits())
+		}
+	}
+
+	if _, err := (Money{100, "USD"}).Split(0); err == nil {
+		t.Error("Expected split into 0 parts to fail")
+	}
+}
+
+func TestMoneyAllocate(t *testing.T) {
+	values := []struct {
+		amount   int64
+		ratios   []int
+		expected []int64
+	}{
+		{100, []int{1, 1, 1}, []int64{34, 33, 33}},
+		{100, []int{70, 20, 10}, []int64{70, 20, 10}},
+		{5, []int{3, 7}, []int64{2, 3}},
+		{-100, []int{1, 1, 1}, []int64{-34, -33, -33}},
+		{2, []int{0, 1, 1, 1}, []int64{0, 1, 1, 0}},
+		{math.MaxInt64, []int{1, 1}, []int64{math.MaxInt64/2 + 1, math.MaxInt64 / 2}},
+	}
+
+	for _, v := range values {
+		parts, err := Money{v.amount, "USD"}.Allocate(v.ratios...)
+
+		if err != nil {
+			t.Errorf("Expected %d to allocate but got %s", v.amount, err)
+			continue
+		}
+
+		for i, part := range parts {
+			if part.MinorUnits() != v.expected[i] {
+				t.Errorf("Expected %d by %v part %d to be %d but got %d", v.amount, v.ratios, i, v.expected[i], part.MinorUnits())
+			}
+		}
+	}
+}
+
+func TestMoneyAllocateWhenInvalid(t *testing.T) {
+	for _, ratios := range [][]int{nil, {0, 0}, {1, -1}} {
+		if _, err := (Money{100, "USD"}).Allocate(ratios...); err == nil {
+			t.Errorf("Expected allocation by %v to fail", ratios)
+		}
+	}
+}
+
+func TestMoneyCompare(t *testing.T) {
+	a, b := Money{100, "USD"}, Money{200, "USD"}
+
+	if c, _ := a.Compare(b); c != -1 {
+		t.Errorf("Expected -1 but got %d", c)
+	}
+
+	if ok, _ := a.LessThan(b); !ok {
+		t.Error("Expected a to be less than b")
+	}
+
+	if ok, _ := a.LessThanOrEqual(a); !ok {
+		t.Error("Expected a to be less than or equal to a")
+	}
+
+	if ok, _ := b.GreaterThan(a); !ok {
+		t.Error("Expected b to be greater than a")
+	}
+
+	if ok, _ := b.GreaterThanOrEqual(b); !ok {
+		t.Error("Expected b to be greater than or equal to b")
+	}
+
+	if ok, err := a.GreaterThanOrEqual(Money{100, "EUR"}); ok || !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+
+	if !a.Equals(Money{100, "USD"}) || a.Equals(Money{100, "EUR"}) {
+		t.Error("Expected equality to compare amount and currency")
+	}
+}
+
+func TestMoneySign(t *testing.T) {
+	if !(Money{0, "USD"}).IsZero() || !(Money{1, "USD"}).IsPositive() || !(Money{-1, "USD"}).IsNegative() {
+		t.Error("Expected sign helpers to follow the amount")
+	}
+}
+
+func TestMoneyFormat(t *testing.T) {
+	values := []struct {
+		m        Money
+		options  Options
+		expected string
+	}{
+		{Money{1050, "USD"}, Options{}, "$10.50"},
+		{Money{-123456, "USD"}, Options{}, "-$1,234.56"},
+		{Money{123456, "EUR"}, Options{"with_currency": true}, "€1.234,56 EUR"},
+		{Money{1234, "JPY"}, Options{}, "¥1,234"},
+		{Money{1234, "BHD"}, Options{}, "ب.د1.234"},
+		{Money{7, "MGA"}, Options{}, "Ar1.4"},
+		{Money{1050, "USD"}, Options{"currency": "EUR"}, "$10.50"},
+		{Money{math.MinInt64, "USD"}, Options{}, "-$92,233,720,368,547,758.08"},
+	}
+
+	for _, v := range values {
+		if currency := v.m.Format(v.options); currency != v.expected {
+			t.Errorf("Expected %s but got %s", v.expected, currency)
+		}
+	}
+
+	if s := (Money{1050, "USD"}).String(); s != "$10.50" {
+		t.Errorf("Expected $10.50 but got %s", s)
+	}
+}
+
+func TestMoneyMulRate(t *testing.T) {
+	values := []struct {
+		amount   int64
+		rate     float64
+		expected int64
+	}{
+		{1000, 0.19, 190},
+		{1005, 0.1, 101},
+		{-1005, 0.1, -101},
+		{1, 0.5, 1},
+		{1, 0.49, 0},
+		{333, 1.0 / 3, 111},
+	}
+
+	for _, v := range values {
+		m, err := Money{v.amount, "USD"}.mulRate(v.rate)
+
+		if err != nil || m.MinorUnits() != v.expected {
+			t.Errorf("Expected %d * %v to be %d but got %d %v", v.amount, v.rate, v.expected, m.MinorUnits(), err)
+		}
+	}
+
+	if _, err := (Money{math.MaxInt64, "USD"}).mulRate(2); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow but got %v", err)
+	}
+}
+
+func TestMoneyJSON(t *testing.T) {
+	for _, m := range []Money{{1050, "USD"}, {-5, "EUR"}, {}} {
+		data, err := json.Marshal(m)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var decoded Money
+
+		if err := json.Unmarshal(data, &decoded); err != nil || decoded != m {
+			t.Errorf("Expected %s to round trip through %s but got %s (%v)", m, data, decoded, err)
+		}
+	}
+
+	if data, _ := json.Marshal(Money{1050, "USD"}); string(data) != `{"amount":1050,"currency":"USD"}` {
+		t.Errorf("Expected {\"amount\":1050,\"currency\":\"USD\"} but got %s", data)
+	}
+
+	var m Money
+
+	if err := json.Unmarshal([]byte(`{"amount":1,"currency":"XYZ"}`), &m); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"amount":"1"}`), &m); err == nil {
+		t.Error("Expected a string amount to fail")
+	}
+}
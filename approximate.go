@@ -0,0 +1,83 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// Granularity sets how ApproximateForDisplay hides an amount
+type Granularity struct {
+	// Step is the multiple in major units the amount is rounded to, e.g. 100
+	Step float64
+
+	// AtLeast rounds the absolute amount down to the step and abbreviates it, e.g.
+	// $1.2K+, rather than rounding it to the nearest step, e.g. about $1,200
+	AtLeast bool
+
+	// Locale selects the phrasing. Only English is available, the default.
+	Locale string
+}
+
+// ApproximateForDisplay formats m approximately, for figures such as salary
+// bands or fundraising totals that should not be shown exactly. Cents are only
+// shown when the step has some. It fails for a step that is not a positive
+// whole number of minor units and for locales other than English.
+func ApproximateForDisplay(m Money, granularity Granularity) (result string, err error) {
+	defer guard(&err)
+
+	if locale := granularity.Locale; locale != "" && !englishLocale(locale) {
+		return "", fmt.Errorf("money: no approximate phrasing for locale %q", locale)
+	}
+
+	c, ok := currencies[m.currency]
+
+	if !ok {
+		return "", fmt.Errorf("%w %q", ErrUnknownCurrency, m.currency)
+	}
+
+	step := uint64(0)
+
+	if s := granularity.Step; s > 0 && !math.IsInf(s, 0) {
+		r := new(big.Rat).Mul(decimalRat(s), big.NewRat(c.units(), 1))
+
+		if r.IsInt() && r.Num().IsInt64() {
+			step = r.Num().Uint64()
+		}
+	}
+
+	if step == 0 {
+		return "", fmt.Errorf("money: invalid approximation step %v for %s", granularity.Step, m.currency)
+	}
+
+	abs := absUnits(m.amount)
+
+	if granularity.AtLeast {
+		abs -= abs % step
+	} else if rem := abs % step; rem*2 >= step {
+		abs += step - rem
+	} else {
+		abs -= rem
+	}
+
+	if abs > math.MaxInt64 {
+		return "", fmt.Errorf("%w: %s rounded to %v", ErrOverflow, m, granularity.Step)
+	}
+
+	amount := int64(abs)
+
+	if m.amount < 0 {
+		amount = -amount
+	}
+
+	options := defaults()
+	options["currency"] = m.currency
+	options["with_cents"] = step%uint64(c.units()) != 0
+	l := cachedLayout(options)
+
+	if granularity.AtLeast {
+		return string(l.appendCompact(nil, amount, true)) + "+", nil
+	}
+
+	return "about " + string(l.appendUnits(nil, amount)), nil
+}
@@ -0,0 +1,55 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestApproximateForDisplay(t *testing.T) {
+	skipWithoutLocaleData(t)
+
+	values := []struct {
+		m           Money
+		granularity Granularity
+		expected    string
+	}{
+		{Money{123456, "USD"}, Granularity{Step: 100}, "about $1,200"},
+		{Money{125000, "USD"}, Granularity{Step: 100}, "about $1,300"},
+		{Money{-123456, "USD"}, Granularity{Step: 100}, "about -$1,200"},
+		{Money{123456, "EUR"}, Granularity{Step: 0.5, Locale: "en-GB"}, "about €1.234,50"},
+		{Money{129999, "USD"}, Granularity{Step: 100, AtLeast: true}, "$1.2K+"},
+		{Money{129999, "USD"}, Granularity{Step: 1, AtLeast: true}, "$1.2K+"},
+		{Money{250000000000, "USD"}, Granularity{Step: 1000000, AtLeast: true}, "$2.5B+"},
+		{Money{99999, "USD"}, Granularity{Step: 100, AtLeast: true}, "$900+"},
+		{Money{4200, "JPY"}, Granularity{Step: 1000}, "about ¥4,000"},
+	}
+
+	for _, v := range values {
+		if result, err := ApproximateForDisplay(v.m, v.granularity); err != nil || result != v.expected {
+			t.Errorf("Expected %s but got %s (%v)", v.expected, result, err)
+		}
+	}
+}
+
+func TestApproximateForDisplayWhenInvalid(t *testing.T) {
+	m := Money{123456, "USD"}
+
+	for _, step := range []float64{0, -100, 0.001, math.NaN(), math.Inf(1), 1e30} {
+		if _, err := ApproximateForDisplay(m, Granularity{Step: step}); err == nil {
+			t.Errorf("Expected step %v to fail", step)
+		}
+	}
+
+	if _, err := ApproximateForDisplay(m, Granularity{Step: 100, Locale: "fr-FR"}); err == nil {
+		t.Errorf("Expected locale fr-FR to fail")
+	}
+
+	if _, err := ApproximateForDisplay(Money{1, "XYZ"}, Granularity{Step: 1}); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+
+	if _, err := ApproximateForDisplay(Money{math.MaxInt64, "USD"}, Granularity{Step: 6e16}); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow but got %v", err)
+	}
+}
@@ -0,0 +1,106 @@
+package money
+
+import (
+	"crypto/ed25519"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RateAttestation is an exchange rate signed by its source, proving which rate
+// a conversion used
+type RateAttestation struct {
+	Pair      Pair
+	Rate      float64
+	Source    string
+	Timestamp time.Time
+	Signature []byte
+}
+
+// AttestedRateProvider supplies signed exchange rates
+type AttestedRateProvider interface {
+	// AttestedRate returns the signed amount of to bought by one unit of from
+	AttestedRate(from, to string) (RateAttestation, error)
+}
+
+// AttestedConversion is the result of ConvertAttested with the attestation of
+// the rate it used
+type AttestedConversion struct {
+	Result      Money
+	Attestation RateAttestation
+}
+
+// Payload returns the bytes signed by the attestation: its pair, rate, source
+// and timestamp in nanoseconds since the Unix epoch, separated by newlines
+func (a RateAttestation) Payload() []byte {
+	fields := []string{
+		a.Pair.String(),
+		strconv.FormatFloat(a.Rate, 'g', -1, 64),
+		a.Source,
+		strconv.FormatInt(a.Timestamp.UnixNano(), 10),
+	}
+
+	return []byte(strings.Join(fields, "\n"))
+}
+
+// Sign returns a with the ed25519 signature of its payload by key
+func (a RateAttestation) Sign(key ed25519.PrivateKey) RateAttestation {
+	a.Signature = ed25519.Sign(key, a.Payload())
+
+	return a
+}
+
+// Verify fails with ErrBadAttestation unless a is signed by key
+func (a RateAttestation) Verify(key ed25519.PublicKey) error {
+	if len(key) != ed25519.PublicKeySize || !ed25519.Verify(key, a.Payload(), a.Signature) {
+		return fmt.Errorf("%w: %s from %q is not signed by the key", ErrBadAttestation, a.Pair, a.Source)
+	}
+
+	return nil
+}
+
+// ConvertAttested converts m into to at the rate attested by provider, rounded
+// half away from zero to the minor unit of to as ConvertMoney does. It fails
+// with ErrBadAttestation when the attestation is for another pair, has an
+// invalid rate or is not signed by key. Amounts already in to are returned
+// without an attestation.
+func ConvertAttested(m Money, to string, provider AttestedRateProvider, key ed25519.PublicKey) (AttestedConversion, error) {
+	to = strings.ToUpper(to)
+	pair := Pair{m.currency, to}
+
+	if _, ok := currencies[to]; ok && m.currency == to {
+		return AttestedConversion{Result: m}, nil
+	}
+
+	if err := pair.Validate(); err != nil {
+		return AttestedConversion{}, err
+	}
+
+	a, err := provider.AttestedRate(m.currency, to)
+
+	if err != nil {
+		return AttestedConversion{}, err
+	}
+
+	if err := a.Verify(key); err != nil {
+		return AttestedConversion{}, err
+	}
+
+	if a.Pair != pair {
+		return AttestedConversion{}, fmt.Errorf("%w: %s attested for %s", ErrBadAttestation, a.Pair, pair)
+	}
+
+	if !(a.Rate > 0) || math.IsInf(a.Rate, 0) {
+		return AttestedConversion{}, fmt.Errorf("%w: rate %v for %s", ErrBadAttestation, a.Rate, pair)
+	}
+
+	result, err := convertUnits(m, to, decimalRat(a.Rate))
+
+	if err != nil {
+		return AttestedConversion{}, err
+	}
+
+	return AttestedConversion{result, a}, nil
+}
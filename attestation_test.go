@@ -0,0 +1,84 @@
+package money
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"testing"
+	"time"
+)
+
+// attestedRates signs the rates of a table with a key
+type attestedRates struct {
+	key   ed25519.PrivateKey
+	rates map[Pair]float64
+}
+
+func (p attestedRates) AttestedRate(from, to string) (RateAttestation, error) {
+	rate, ok := p.rates[Pair{from, to}]
+
+	if !ok {
+		return RateAttestation{}, ErrNoRate
+	}
+
+	a := RateAttestation{Pair{from, to}, rate, "ECB", time.Date(2026, 3, 1, 16, 0, 0, 0, time.UTC), nil}
+
+	return a.Sign(p.key), nil
+}
+
+func TestConvertAttested(t *testing.T) {
+	public, private, _ := ed25519.GenerateKey(nil)
+	provider := attestedRates{private, map[Pair]float64{{"EUR", "USD"}: 1.0825}}
+
+	c, err := ConvertAttested(Money{10000, "EUR"}, "usd", provider, public)
+
+	if err != nil || c.Result != (Money{10825, "USD"}) || c.Attestation.Rate != 1.0825 || c.Attestation.Source != "ECB" {
+		t.Fatalf("Expected $108.25 at the attested rate but got %+v %v", c, err)
+	}
+
+	if err := c.Attestation.Verify(public); err != nil {
+		t.Errorf("Expected the carried attestation to verify but got %v", err)
+	}
+
+	tampered := c.Attestation
+	tampered.Rate = 1.09
+
+	if err := tampered.Verify(public); !errors.Is(err, ErrBadAttestation) {
+		t.Errorf("Expected ErrBadAttestation but got %v", err)
+	}
+
+	if c, err := ConvertAttested(Money{100, "USD"}, "USD", provider, public); err != nil || c.Result != (Money{100, "USD"}) || c.Attestation.Signature != nil {
+		t.Errorf("Expected the amount without an attestation but got %+v %v", c, err)
+	}
+}
+
+func TestConvertAttestedWhenInvalid(t *testing.T) {
+	public, private, _ := ed25519.GenerateKey(nil)
+	other, _, _ := ed25519.GenerateKey(nil)
+
+	values := []struct {
+		provider AttestedRateProvider
+		key      ed25519.PublicKey
+		target   error
+	}{
+		{attestedRates{private, map[Pair]float64{{"EUR", "USD"}: 1.08}}, other, ErrBadAttestation},
+		{attestedRates{private, map[Pair]float64{{"EUR", "USD"}: 1.08}}, nil, ErrBadAttestation},
+		{attestedRates{private, map[Pair]float64{{"EUR", "USD"}: -1}}, public, ErrBadAttestation},
+		{attestedRates{private, nil}, public, ErrNoRate},
+		{swappedRates{attestedRates{private, map[Pair]float64{{"USD", "EUR"}: 0.92}}}, public, ErrBadAttestation},
+	}
+
+	for _, v := range values {
+		if _, err := ConvertAttested(Money{100, "EUR"}, "USD", v.provider, v.key); !errors.Is(err, v.target) {
+			t.Errorf("Expected %v but got %v", v.target, err)
+		}
+	}
+}
+
+// swappedRates attests the inverse pair of the one asked for
+type swappedRates struct {
+	attestedRates
+}
+
+func (p swappedRates) AttestedRate(from, to string) (RateAttestation, error) {
+	return p.attestedRates.AttestedRate(to, from)
+}
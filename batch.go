@@ -0,0 +1,131 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Batch holds many amounts of one currency as a plain slice of minor units,
+// avoiding the per value overhead of a []Money for large analytics jobs
+type Batch struct {
+	amounts  []int64
+	currency string
+}
+
+// NewBatchFromMinorUnits returns a Batch of amounts in the currency. The batch
+// uses amounts without copying, so the caller must not modify it afterwards.
+func NewBatchFromMinorUnits(amounts []int64, code string) (Batch, error) {
+	code = strings.ToUpper(code)
+
+	if _, ok := currencies[code]; !ok {
+		return Batch{}, fmt.Errorf("%w %q", ErrUnknownCurrency, code)
+	}
+
+	return Batch{amounts, code}, nil
+}
+
+// Len returns the number of amounts
+func (b Batch) Len() int {
+	return len(b.amounts)
+}
+
+// Currency returns the ISO code of the currency
+func (b Batch) Currency() string {
+	return b.currency
+}
+
+// At returns the amount at index i
+func (b Batch) At(i int) Money {
+	return Money{b.amounts[i], b.currency}
+}
+
+// MinorUnits returns the amounts, which must not be modified
+func (b Batch) MinorUnits() []int64 {
+	return b.amounts
+}
+
+// Sum returns the total of the amounts, failing when it overflows
+func (b Batch) Sum() (Money, error) {
+	var sum int64
+
+	for _, a := range b.amounts {
+		s := sum + a
+
+		if (s > sum) != (a > 0) {
+			return Money{}, fmt.Errorf("%w: sum of %d %s amounts", ErrOverflow, len(b.amounts), b.currency)
+		}
+
+		sum = s
+	}
+
+	return Money{sum, b.currency}, nil
+}
+
+// Min returns the smallest amount, failing for an empty batch
+func (b Batch) Min() (Money, error) {
+	if len(b.amounts) == 0 {
+		return Money{}, fmt.Errorf("money: no minimum of an empty batch")
+	}
+
+	smallest := b.amounts[0]
+
+	for _, a := range b.amounts[1:] {
+		if a < smallest {
+			smallest = a
+		}
+	}
+
+	return Money{smallest, b.currency}, nil
+}
+
+// Max returns the largest amount, failing for an empty batch
+func (b Batch) Max() (Money, error) {
+	if len(b.amounts) == 0 {
+		return Money{}, fmt.Errorf("money: no maximum of an empty batch")
+	}
+
+	largest := b.amounts[0]
+
+	for _, a := range b.amounts[1:] {
+		if a > largest {
+			largest = a
+		}
+	}
+
+	return Money{largest, b.currency}, nil
+}
+
+// Format formats every amount as Money.Format does, interpreting opts once
+func (b Batch) Format(opts ...Options) (formatted []string) {
+	defer guard(nil)
+
+	options := defaults()
+
+	if len(opts) > 0 {
+		noteDeprecated(opts[0])
+		options = override(options, opts[0])
+	}
+
+	options["currency"] = b.currency
+	result := make([]string, len(b.amounts))
+
+	if fn, ok := lookupMoneyFormat(options["format"].(string)); ok {
+		options["format"] = ""
+
+		for i := range b.amounts {
+			result[i] = fn(b.At(i), override(Options{}, options))
+		}
+
+		return result
+	}
+
+	l := cachedLayout(options)
+	buf := make([]byte, 0, 64)
+
+	for i, a := range b.amounts {
+		buf = l.appendUnits(buf[:0], a)
+		result[i] = string(buf)
+	}
+
+	return result
+}
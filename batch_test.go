@@ -0,0 +1,76 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestBatch(t *testing.T) {
+	b, err := NewBatchFromMinorUnits([]int64{1050, -200, 30000, 0}, "usd")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if b.Len() != 4 || b.Currency() != "USD" || b.At(1) != (Money{-200, "USD"}) {
+		t.Errorf("Expected 4 USD amounts but got %d %s %s", b.Len(), b.Currency(), b.At(1))
+	}
+
+	if sum, err := b.Sum(); err != nil || sum != (Money{30850, "USD"}) {
+		t.Errorf("Expected $308.50 but got %s %v", sum, err)
+	}
+
+	if min, err := b.Min(); err != nil || min != (Money{-200, "USD"}) {
+		t.Errorf("Expected -$2.00 but got %s %v", min, err)
+	}
+
+	if max, err := b.Max(); err != nil || max != (Money{30000, "USD"}) {
+		t.Errorf("Expected $300.00 but got %s %v", max, err)
+	}
+}
+
+func TestBatchWhenInvalid(t *testing.T) {
+	if _, err := NewBatchFromMinorUnits(nil, "XYZ"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+
+	empty, _ := NewBatchFromMinorUnits(nil, "USD")
+
+	if sum, err := empty.Sum(); err != nil || !sum.IsZero() {
+		t.Errorf("Expected an empty sum of zero but got %s %v", sum, err)
+	}
+
+	if _, err := empty.Min(); err == nil {
+		t.Error("Expected minimum of an empty batch to fail")
+	}
+
+	if _, err := empty.Max(); err == nil {
+		t.Error("Expected maximum of an empty batch to fail")
+	}
+
+	for _, amounts := range [][]int64{{math.MaxInt64, 1}, {math.MinInt64, -1}} {
+		b, _ := NewBatchFromMinorUnits(amounts, "USD")
+
+		if _, err := b.Sum(); !errors.Is(err, ErrOverflow) {
+			t.Errorf("Expected ErrOverflow for %v but got %v", amounts, err)
+		}
+	}
+}
+
+func TestBatchFormat(t *testing.T) {
+	amounts := []int64{123456, -50, 0, 7, math.MinInt64}
+	b, _ := NewBatchFromMinorUnits(amounts, "EUR")
+
+	for _, options := range []Options{{}, {"with_currency": true, "with_symbol_space": true}, {"with_cents": false, "with_thousands_separator": false}} {
+		for i, s := range b.Format(options) {
+			if expected := b.At(i).Format(options); s != expected {
+				t.Errorf("Expected %s but got %s", expected, s)
+			}
+		}
+	}
+
+	if s := b.Format()[0]; s != "€1.234,56" {
+		t.Errorf("Expected €1.234,56 but got %s", s)
+	}
+}
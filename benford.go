@@ -0,0 +1,133 @@
+package money
+
+import (
+	"fmt"
+	"math"
+)
+
+// benfordZ is the Z statistic beyond which BenfordScreen flags a digit, the two
+// sided 95% bound
+const benfordZ = 1.96
+
+// BenfordDigit compares the observed and the expected frequency of a leading
+// digit. Z is the Z statistic of the difference, with a continuity correction.
+type BenfordDigit struct {
+	Digit    int
+	Observed float64
+	Expected float64
+	Z        float64
+}
+
+// BenfordReport is the first digit test of BenfordScreen
+type BenfordReport struct {
+	// Count is the number of amounts tested, those not zero
+	Count int
+
+	// Digits are the frequencies of the leading digits 1 to 9
+	Digits [9]BenfordDigit
+
+	// MAD is the mean absolute deviation of the frequencies; Nigrini takes a
+	// MAD above 0.015 as nonconformity
+	MAD float64
+
+	// Suspicious are the digits whose Z statistic exceeds 1.96
+	Suspicious []int
+
+	// Flagged are the indexes of the amounts with a suspicious leading digit
+	Flagged []int
+}
+
+// BenfordDistribution returns the frequencies of the leading digits 1 to 9 by
+// Benford's law, log10(1 + 1/d)
+func BenfordDistribution() [9]float64 {
+	var expected [9]float64
+
+	for d := range expected {
+		expected[d] = math.Log10(1 + 1/float64(d+1))
+	}
+
+	return expected
+}
+
+// LeadingDigitDistribution returns the frequencies of the leading digits 1 to 9
+// of the amounts, leaving out zero ones. Leading digits do not depend on the
+// scale, so amounts in different currencies can be mixed.
+func LeadingDigitDistribution(ms []Money) [9]float64 {
+	var counts [9]int
+	n := 0
+
+	for _, m := range ms {
+		if d := leadingDigit(m); d > 0 {
+			counts[d-1]++
+			n++
+		}
+	}
+
+	var frequencies [9]float64
+
+	for d, c := range counts {
+		if n > 0 {
+			frequencies[d] = float64(c) / float64(n)
+		}
+	}
+
+	return frequencies
+}
+
+// BenfordScreen runs the first digit test of Benford's law on the amounts,
+// flagging the digits which deviate from it and the amounts starting with them.
+// The test is only meaningful for a few hundred amounts or more spanning
+// several orders of magnitude, such as expenses; it fails when all are zero.
+func BenfordScreen(ms []Money) (BenfordReport, error) {
+	r := BenfordReport{}
+
+	for _, m := range ms {
+		if leadingDigit(m) > 0 {
+			r.Count++
+		}
+	}
+
+	if r.Count == 0 {
+		return BenfordReport{}, fmt.Errorf("money: no amounts to screen")
+	}
+
+	observed, expected := LeadingDigitDistribution(ms), BenfordDistribution()
+	n := float64(r.Count)
+	suspicious := map[int]bool{}
+
+	for i := range r.Digits {
+		po, pe := observed[i], expected[i]
+		z := (math.Abs(po-pe) - 1/(2*n)) / math.Sqrt(pe*(1-pe)/n)
+
+		if z < 0 {
+			z = 0
+		}
+
+		r.Digits[i] = BenfordDigit{i + 1, po, pe, z}
+		r.MAD += math.Abs(po-pe) / 9
+
+		if z > benfordZ {
+			r.Suspicious = append(r.Suspicious, i+1)
+			suspicious[i+1] = true
+		}
+	}
+
+	for i, m := range ms {
+		if suspicious[leadingDigit(m)] {
+			r.Flagged = append(r.Flagged, i)
+		}
+	}
+
+	return r, nil
+}
+
+// leadingDigit returns the first digit of the amount, or 0 for zero
+func leadingDigit(m Money) int {
+	units := absUnits(m.amount)
+
+	for units >= 10 {
+		units /= 10
+	}
+
+	return int(units)
+}
@@ -0,0 +1,80 @@
+package money
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLeadingDigitDistribution(t *testing.T) {
+	ms := []Money{{123, "USD"}, {-1999, "EUR"}, {0, "USD"}, {5, "JPY"}, {math.MinInt64, "USD"}}
+	expected := [9]float64{0.5, 0, 0, 0, 0.25, 0, 0, 0, 0.25}
+
+	if got := LeadingDigitDistribution(ms); got != expected {
+		t.Errorf("Expected %v but got %v", expected, got)
+	}
+
+	if got := LeadingDigitDistribution(nil); got != ([9]float64{}) {
+		t.Errorf("Expected no frequencies but got %v", got)
+	}
+}
+
+func TestBenfordDistribution(t *testing.T) {
+	expected := BenfordDistribution()
+	sum := 0.0
+
+	for _, p := range expected {
+		sum += p
+	}
+
+	if math.Abs(expected[0]-0.30103) > 1e-5 || math.Abs(sum-1) > 1e-12 {
+		t.Errorf("Expected Benford's frequencies but got %v", expected)
+	}
+}
+
+func TestBenfordScreen(t *testing.T) {
+	// amounts growing 1% at a time follow Benford's law closely
+	var ms []Money
+
+	for v := 100.0; v < 1e8; v *= 1.01 {
+		ms = append(ms, Money{int64(v), "USD"})
+	}
+
+	r, err := BenfordScreen(ms)
+
+	if err != nil || r.Count != len(ms) || r.MAD > 0.006 || len(r.Suspicious) != 0 {
+		t.Errorf("Expected Benford's law to hold but got %+v %v", r, err)
+	}
+
+	// expenses just under an approval limit of $5,000
+	for i := 0; i < 200; i++ {
+		ms = append(ms, Money{499000 + int64(i), "USD"})
+	}
+
+	r, err = BenfordScreen(ms)
+
+	if err != nil || r.MAD < 0.015 {
+		t.Errorf("Expected nonconformity but got %v %v", r.MAD, err)
+	}
+
+	for _, d := range r.Digits {
+		if d.Digit != 4 && d.Z >= r.Digits[3].Z {
+			t.Errorf("Expected the leading digit 4 to deviate most but got %+v", r.Digits)
+		}
+	}
+
+	flagged := 0
+
+	for _, i := range r.Flagged {
+		if leadingDigit(ms[i]) == 4 {
+			flagged++
+		}
+	}
+
+	if len(r.Suspicious) == 0 || flagged < 200 {
+		t.Errorf("Expected the amounts starting with 4 to be flagged but got %v", r.Suspicious)
+	}
+
+	if _, err := BenfordScreen([]Money{{0, "USD"}}); err == nil {
+		t.Error("Expected zero amounts to be rejected")
+	}
+}
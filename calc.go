@@ -0,0 +1,144 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// Calculation chains arithmetic on an amount, keeping fractions of a minor unit
+// exactly until Round, and carries the first error through to Result, e.g.
+//
+//	gross, err := money.Calc(price).Add(shipping).MulDecimal("1.19").Round(money.HalfEven).Result()
+//
+// A Calculation is not safe for concurrent use.
+type Calculation struct {
+	currency string
+	minor    *big.Rat
+	err      error
+}
+
+// Calc starts a Calculation from m
+func Calc(m Money) *Calculation {
+	c := &Calculation{currency: m.currency, minor: new(big.Rat).SetInt64(m.amount)}
+
+	if _, ok := currencies[m.currency]; !ok {
+		c.err = fmt.Errorf("%w %q", ErrUnknownCurrency, m.currency)
+	}
+
+	return c
+}
+
+// Add adds o, failing when currencies differ
+func (c *Calculation) Add(o Money) *Calculation {
+	if c.err == nil {
+		if c.err = (Money{currency: c.currency}).sameCurrency(o); c.err == nil {
+			c.minor.Add(c.minor, new(big.Rat).SetInt64(o.amount))
+		}
+	}
+
+	return c
+}
+
+// Subtract subtracts o, failing when currencies differ
+func (c *Calculation) Subtract(o Money) *Calculation {
+	if c.err == nil {
+		if c.err = (Money{currency: c.currency}).sameCurrency(o); c.err == nil {
+			c.minor.Sub(c.minor, new(big.Rat).SetInt64(o.amount))
+		}
+	}
+
+	return c
+}
+
+// Multiply multiplies by n
+func (c *Calculation) Multiply(n int64) *Calculation {
+	if c.err == nil {
+		c.minor.Mul(c.minor, new(big.Rat).SetInt64(n))
+	}
+
+	return c
+}
+
+// MulDecimal multiplies by the plain decimal d, e.g. "1.19", failing for
+// invalid decimals
+func (c *Calculation) MulDecimal(d string) *Calculation {
+	if c.err == nil {
+		var r *big.Rat
+
+		if r, c.err = parseFactor(d); c.err == nil {
+			c.minor.Mul(c.minor, r)
+		}
+	}
+
+	return c
+}
+
+// DivDecimal divides by the plain decimal d, failing for invalid decimals and zero
+func (c *Calculation) DivDecimal(d string) *Calculation {
+	if c.err == nil {
+		var r *big.Rat
+
+		if r, c.err = parseFactor(d); c.err == nil && r.Sign() == 0 {
+			c.err = errors.New("money: division by zero")
+		}
+
+		if c.err == nil {
+			c.minor.Quo(c.minor, r)
+		}
+	}
+
+	return c
+}
+
+// Round rounds to minor units by mode
+func (c *Calculation) Round(mode RoundingMode) *Calculation {
+	if c.err == nil && !mode.valid() {
+		c.err = fmt.Errorf("money: invalid rounding mode %s", mode)
+	}
+
+	if c.err == nil {
+		units, ok := roundRatMode(c.minor, mode)
+
+		if !ok {
+			c.err = fmt.Errorf("%w: %s %s", ErrOverflow, c.minor.FloatString(0), c.currency)
+		} else {
+			c.minor.SetInt64(units)
+		}
+	}
+
+	return c
+}
+
+// Result returns the amount, or the first error of the chain. It fails when the
+// amount holds a fraction of a minor unit, left by MulDecimal or DivDecimal
+// without a Round after them, or does not fit in Money.
+func (c *Calculation) Result() (Money, error) {
+	if c.err != nil {
+		return Money{}, c.err
+	}
+
+	if !c.minor.IsInt() {
+		return Money{}, fmt.Errorf("money: %s minor units of %s need rounding", c.minor.FloatString(4), c.currency)
+	}
+
+	if !c.minor.Num().IsInt64() {
+		return Money{}, fmt.Errorf("%w: %s %s", ErrOverflow, c.minor.Num(), c.currency)
+	}
+
+	return Money{c.minor.Num().Int64(), c.currency}, nil
+}
+
+// factorText is a plain decimal factor, read as FromDecimal reads a Decimal
+type factorText string
+
+func (f factorText) String() string {
+	return string(f)
+}
+
+// parseFactor parses the plain decimal d, bounded as FromDecimal bounds decimals
+func parseFactor(d string) (*big.Rat, error) {
+	_, r, err := decimalValue(factorText(d), "")
+
+	return r, err
+}
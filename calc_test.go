@@ -0,0 +1,68 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestCalc(t *testing.T) {
+	price, shipping := Money{1000, "EUR"}, Money{495, "EUR"}
+
+	values := []struct {
+		calc     *Calculation
+		expected Money
+	}{
+		{Calc(price).Add(shipping).MulDecimal("1.19").Round(HalfEven), Money{1779, "EUR"}},
+		{Calc(price).Subtract(shipping).Multiply(3), Money{1515, "EUR"}},
+		{Calc(Money{25, "EUR"}).DivDecimal("10").Round(HalfEven), Money{2, "EUR"}},
+		{Calc(Money{25, "EUR"}).DivDecimal("10").Round(HalfUp), Money{3, "EUR"}},
+		{Calc(Money{-25, "EUR"}).DivDecimal("10").Round(Floor), Money{-3, "EUR"}},
+		{Calc(Money{100, "EUR"}).DivDecimal("3").Multiply(3), Money{100, "EUR"}},
+		{Calc(Money{100, "JPY"}).MulDecimal("1.5e-1").Round(Ceiling), Money{15, "JPY"}},
+	}
+
+	for _, v := range values {
+		if got, err := v.calc.Result(); err != nil || got != v.expected {
+			t.Errorf("Expected %s but got %s %v", v.expected, got, err)
+		}
+	}
+}
+
+func TestCalcCarriesTheFirstError(t *testing.T) {
+	_, err := Calc(Money{100, "USD"}).Add(Money{100, "EUR"}).MulDecimal("abc").Round(HalfUp).Result()
+
+	if !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+
+	if _, err := Calc(Money{100, "XXX"}).Result(); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+
+	if _, err := Calc(Money{100, "USD"}).DivDecimal("0").Result(); err == nil {
+		t.Error("Expected a division by zero to be rejected")
+	}
+
+	if _, err := Calc(Money{100, "USD"}).MulDecimal("1e400").Result(); err == nil {
+		t.Error("Expected an exponent beyond the bound to be rejected")
+	}
+
+	if _, err := Calc(Money{100, "USD"}).Round(RoundingMode(-1)).Result(); err == nil {
+		t.Error("Expected an invalid rounding mode to be rejected")
+	}
+}
+
+func TestCalcWhenUnrounded(t *testing.T) {
+	if _, err := Calc(Money{100, "USD"}).MulDecimal("1.005").Result(); err == nil {
+		t.Error("Expected a fraction of a minor unit to need rounding")
+	}
+
+	if _, err := Calc(Money{math.MaxInt64, "USD"}).Multiply(2).Result(); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow but got %v", err)
+	}
+
+	if _, err := Calc(Money{math.MaxInt64, "USD"}).MulDecimal("1.5").Round(HalfUp).Result(); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow but got %v", err)
+	}
+}
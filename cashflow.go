@@ -0,0 +1,134 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// CashFlow is an amount received (positive) or paid (negative) on a date
+type CashFlow struct {
+	Date   time.Time
+	Amount Money
+}
+
+// NPV returns the net present value of flows at the date of the earliest one,
+// discounting each flow at an annual rate over its ACT/365 year fraction.
+// Discounting uses floating point factors; the sum is rounded once, half away
+// from zero, to minor units.
+func NPV(rate float64, flows []CashFlow) (Money, error) {
+	if !(rate > -1) || math.IsInf(rate, 0) {
+		return Money{}, fmt.Errorf("money: discount rate %v must be finite and greater than -1", rate)
+	}
+
+	start, err := checkFlows(flows)
+
+	if err != nil {
+		return Money{}, err
+	}
+
+	npv := math.Round(presentValue(rate, start, flows))
+
+	// NaN and infinities fail the range check too
+	if !(npv < math.MaxInt64 && npv >= math.MinInt64) {
+		return Money{}, fmt.Errorf("%w: net present value", ErrOverflow)
+	}
+
+	return Money{int64(npv), flows[0].Amount.currency}, nil
+}
+
+// IRR returns the annual rate at which the NPV of flows is zero, found by bisection.
+// Flows must include both payments and receipts.
+func IRR(flows []CashFlow) (float64, error) {
+	start, err := checkFlows(flows)
+
+	if err != nil {
+		return 0, err
+	}
+
+	lo, hi := -0.999999999, 1.0
+	flo, fhi := presentValue(lo, start, flows), presentValue(hi, start, flows)
+
+	for flo*fhi > 0 && hi < 1e6 {
+		hi *= 2
+		fhi = presentValue(hi, start, flows)
+	}
+
+	if flo*fhi > 0 {
+		return 0, fmt.Errorf("money: internal rate of return not found")
+	}
+
+	for i := 0; i < 200 && hi-lo > 1e-12; i++ {
+		mid := (lo + hi) / 2
+
+		if fmid := presentValue(mid, start, flows); fmid*flo > 0 {
+			lo, flo = mid, fmid
+		} else {
+			hi = mid
+		}
+	}
+
+	return (lo + hi) / 2, nil
+}
+
+// PaybackPeriod returns the time from the earliest flow until the cumulative sum
+// of flows, taken in date order, stops being negative
+func PaybackPeriod(flows []CashFlow) (time.Duration, error) {
+	start, err := checkFlows(flows)
+
+	if err != nil {
+		return 0, err
+	}
+
+	sorted := append([]CashFlow(nil), flows...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Date.Before(sorted[j].Date) })
+
+	total := Money{0, sorted[0].Amount.currency}
+	invested := false
+
+	for _, flow := range sorted {
+		if total, err = total.Add(flow.Amount); err != nil {
+			return 0, err
+		}
+
+		if total.IsNegative() {
+			invested = true
+		} else if invested {
+			return flow.Date.Sub(start), nil
+		}
+	}
+
+	return 0, fmt.Errorf("money: flows never pay back")
+}
+
+// checkFlows ensures flows are not empty and share a currency, returning the earliest date
+func checkFlows(flows []CashFlow) (time.Time, error) {
+	if len(flows) == 0 {
+		return time.Time{}, fmt.Errorf("money: no cash flows")
+	}
+
+	start := flows[0].Date
+
+	for _, flow := range flows {
+		if err := flows[0].Amount.sameCurrency(flow.Amount); err != nil {
+			return time.Time{}, err
+		}
+
+		if flow.Date.Before(start) {
+			start = flow.Date
+		}
+	}
+
+	return start, nil
+}
+
+// presentValue returns the sum of flows discounted to start, in minor units
+func presentValue(rate float64, start time.Time, flows []CashFlow) (pv float64) {
+	for _, flow := range flows {
+		years := flow.Date.Sub(start).Hours() / 24 / 365
+		pv += float64(flow.Amount.amount) / math.Pow(1+rate, years)
+	}
+
+	return pv
+}
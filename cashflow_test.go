@@ -0,0 +1,106 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func sampleFlows() []CashFlow {
+	day := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+
+	return []CashFlow{
+		{day(2021, 1, 1), Money{-100000, "USD"}},
+		{day(2022, 1, 1), Money{60000, "USD"}},
+		{day(2023, 1, 1), Money{60500, "USD"}},
+	}
+}
+
+func TestNPV(t *testing.T) {
+	npv, err := NPV(0.1, sampleFlows())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if npv != (Money{4545, "USD"}) {
+		t.Errorf("Expected $45.45 but got %s", npv)
+	}
+
+	if npv, _ := NPV(0, sampleFlows()); npv != (Money{20500, "USD"}) {
+		t.Errorf("Expected $205.00 but got %s", npv)
+	}
+}
+
+func TestNPVWhenInvalid(t *testing.T) {
+	if _, err := NPV(0.1, nil); err == nil {
+		t.Error("Expected no flows to fail")
+	}
+
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	huge := []CashFlow{{day, Money{math.MaxInt64, "USD"}}, {day, Money{math.MaxInt64, "USD"}}}
+
+	for _, tc := range []struct {
+		name  string
+		rate  float64
+		flows []CashFlow
+	}{
+		{"rate of -100%", -1, sampleFlows()},
+		{"NaN rate", math.NaN(), sampleFlows()},
+		{"infinite rate", math.Inf(1), sampleFlows()},
+		{"negative infinite rate", math.Inf(-1), sampleFlows()},
+		{"overflowing sum", 0, huge},
+		{"overflowing discount", -0.999999999, append(huge[:1:1], CashFlow{day.AddDate(100, 0, 0), Money{math.MaxInt64, "USD"}})},
+	} {
+		if npv, err := NPV(tc.rate, tc.flows); err == nil {
+			t.Errorf("Expected %s to fail but got %s", tc.name, npv)
+		}
+	}
+
+	if _, err := NPV(0, huge); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow but got %v", err)
+	}
+
+	mixed := append(sampleFlows(), CashFlow{time.Now(), Money{100, "EUR"}})
+
+	if _, err := NPV(0.1, mixed); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+}
+
+func TestIRR(t *testing.T) {
+	rate, err := IRR(sampleFlows())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if npv, _ := NPV(rate, sampleFlows()); !npv.IsZero() {
+		t.Errorf("Expected NPV at IRR to be zero but got %s", npv)
+	}
+
+	if math.Abs(rate-0.1337) > 0.0001 {
+		t.Errorf("Expected IRR near 13.37%% but got %v", rate)
+	}
+
+	if _, err := IRR(sampleFlows()[1:]); err == nil {
+		t.Error("Expected receipts only to have no IRR")
+	}
+}
+
+func TestPaybackPeriod(t *testing.T) {
+	period, err := PaybackPeriod(sampleFlows())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := 730 * 24 * time.Hour; period != expected {
+		t.Errorf("Expected %s but got %s", expected, period)
+	}
+
+	if _, err := PaybackPeriod(sampleFlows()[:2]); err == nil {
+		t.Error("Expected flows not to pay back")
+	}
+}
@@ -0,0 +1,118 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Changeover describes the adoption of a new currency at a fixed legal rate,
+// during which prices must be displayed in both currencies
+type Changeover struct {
+	// Old and New are the ISO codes of the replaced and the adopted currency
+	Old, New string
+
+	// Rate is the amount of the old currency worth one unit of the new one
+	Rate float64
+
+	// Start and End bound the dual display period, End excluded; a zero End
+	// leaves the period open
+	Start, End time.Time
+}
+
+var changeovers = struct {
+	sync.RWMutex
+	list []Changeover
+}{list: []Changeover{
+	{"HRK", "EUR", 7.5345, time.Date(2022, 9, 5, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+}}
+
+// RegisterChangeover registers a dual display period, failing when it overlaps
+// another period of either currency
+func RegisterChangeover(c Changeover) error {
+	c.Old, c.New = strings.ToUpper(c.Old), strings.ToUpper(c.New)
+
+	if err := (Pair{c.Old, c.New}).Validate(); err != nil {
+		return err
+	}
+
+	if !(c.Rate > 0) || math.IsInf(c.Rate, 0) {
+		return fmt.Errorf("money: invalid changeover rate %v for %s", c.Rate, Pair{c.Old, c.New})
+	}
+
+	if !c.End.IsZero() && !c.End.After(c.Start) {
+		return fmt.Errorf("money: changeover for %s ends before it starts", Pair{c.Old, c.New})
+	}
+
+	return unlessFrozen(func() error {
+		changeovers.Lock()
+		defer changeovers.Unlock()
+
+		for _, o := range changeovers.list {
+			shared := o.Old == c.Old || o.Old == c.New || o.New == c.Old || o.New == c.New
+
+			if shared && (c.End.IsZero() || c.End.After(o.Start)) && (o.End.IsZero() || o.End.After(c.Start)) {
+				return fmt.Errorf("money: changeover for %s overlaps %s", Pair{c.Old, c.New}, Pair{o.Old, o.New})
+			}
+		}
+
+		changeovers.list = append(changeovers.list, c)
+
+		return nil
+	})
+}
+
+// ActiveChangeover returns the changeover involving the currency whose dual
+// display period includes at
+func ActiveChangeover(code string, at time.Time) (Changeover, bool) {
+	code = strings.ToUpper(code)
+
+	changeovers.RLock()
+	defer changeovers.RUnlock()
+
+	for _, c := range changeovers.list {
+		if (c.Old == code || c.New == code) && !at.Before(c.Start) && (c.End.IsZero() || at.Before(c.End)) {
+			return c, true
+		}
+	}
+
+	return Changeover{}, false
+}
+
+// Counterpart returns m converted at the legal rate to the other currency of
+// the changeover, rounded half away from zero to its minor unit
+func (c Changeover) Counterpart(m Money) (Money, error) {
+	rate := decimalRat(c.Rate)
+
+	switch m.currency {
+	case c.Old:
+		return convertUnits(m, c.New, rate.Inv(rate))
+	case c.New:
+		return convertUnits(m, c.Old, rate)
+	}
+
+	return Money{}, fmt.Errorf("%w: %s is not part of %s", ErrCurrencyMismatch, m.currency, Pair{c.Old, c.New})
+}
+
+// FormatDual formats m followed by its counterpart in parentheses, e.g.
+// "€10,00 (kn75,35)", when a changeover of its currency is active at the time.
+// Otherwise it formats m alone, as Money.Format does.
+func FormatDual(m Money, at time.Time, opts ...Options) (string, error) {
+	noteDeprecated(opts...)
+
+	c, ok := ActiveChangeover(m.currency, at)
+
+	if !ok {
+		return m.Format(opts...), nil
+	}
+
+	counterpart, err := c.Counterpart(m)
+
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s (%s)", m.Format(opts...), counterpart.Format(opts...)), nil
+}
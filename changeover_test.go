@@ -0,0 +1,70 @@
+package money
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFormatDual(t *testing.T) {
+	during := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	after := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	values := []struct {
+		m        Money
+		at       time.Time
+		expected string
+	}{
+		{Money{1000, "EUR"}, during, "€10,00 (kn75,35)"},
+		{Money{7535, "HRK"}, during, "kn75,35 (€10,00)"},
+		{Money{-100, "HRK"}, during, "-kn1,00 (-€0,13)"},
+		{Money{1000, "EUR"}, after, "€10,00"},
+		{Money{1000, "USD"}, during, "$10.00"},
+	}
+
+	for _, v := range values {
+		s, err := FormatDual(v.m, v.at)
+
+		if err != nil || s != v.expected {
+			t.Errorf("Expected %s but got %s %v", v.expected, s, err)
+		}
+	}
+}
+
+func TestChangeoverCounterpart(t *testing.T) {
+	c, ok := ActiveChangeover("hrk", time.Date(2022, 9, 5, 0, 0, 0, 0, time.UTC))
+
+	if !ok {
+		t.Fatal("Expected the HRK changeover to be active")
+	}
+
+	if _, err := c.Counterpart(Money{100, "USD"}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+}
+
+func TestRegisterChangeover(t *testing.T) {
+	start := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if err := RegisterChangeover(Changeover{"bgn", "eur", 1.95583, start, start.AddDate(1, 0, 0)}); err != nil {
+		t.Fatal(err)
+	}
+
+	if s, _ := FormatDual(Money{100, "BGN"}, start); s != "1.00лв (€0,51)" {
+		t.Errorf("Expected 1.00лв (€0,51) but got %s", s)
+	}
+
+	invalid := []Changeover{
+		{"LTL", "EUR", 3.4528, start.AddDate(0, 6, 0), time.Time{}},
+		{"LTL", "LTL", 1, start, time.Time{}},
+		{"LTL", "XYZ", 1, start, time.Time{}},
+		{"LTL", "USD", 0, start, time.Time{}},
+		{"LTL", "USD", 1, start, start},
+	}
+
+	for _, c := range invalid {
+		if err := RegisterChangeover(c); err == nil {
+			t.Errorf("Expected %+v to be rejected", c)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package money
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// CurrencyChoice describes a currency for a currency picker
+type CurrencyChoice struct {
+	Code   string
+	Name   string
+	Symbol string
+
+	// Region is the ISO 3166 region the code derives from, e.g. "CH" for CHF or
+	// "EU" for EUR, to pick a flag; it is empty for supranational and non ISO
+	// currencies such as XAF and BTC, and may name a former region
+	Region string
+}
+
+// CurrencyChoices returns the registered currencies for a picker, those set with
+// SetCurrencyDisplayOrder first and the others sorted by name. The registry only
+// holds English names, so locales other than English are rejected.
+func CurrencyChoices(locale string) ([]CurrencyChoice, error) {
+	if !englishLocale(locale) {
+		return nil, fmt.Errorf("money: no currency names for locale %q", locale)
+	}
+
+	choices := make([]CurrencyChoice, 0, len(currencies))
+
+	for code, c := range currencies {
+		choices = append(choices, CurrencyChoice{code, c.Name, resolveSymbol(code, c), currencyRegion(code, c)})
+	}
+
+	ranks := currencyRanks()
+
+	sort.Slice(choices, func(i, j int) bool {
+		a, b := choices[i], choices[j]
+		_, ranked := ranks[a.Code]
+		_, other := ranks[b.Code]
+
+		if ranked || other {
+			return currencyBefore(ranks, a.Code, b.Code)
+		}
+
+		if x, y := strings.ToLower(a.Name), strings.ToLower(b.Name); x != y {
+			return x < y
+		}
+
+		return a.Code < b.Code
+	})
+
+	return choices, nil
+}
+
+// DefaultLocaleFor returns a BCP 47 locale for the region of the currency, e.g.
+// "und-CH" for CHF. The registry holds no language data, so the language is
+// "und", undetermined, for a locale matcher to fill in.
+func DefaultLocaleFor(code string) (string, error) {
+	code = strings.ToUpper(code)
+	c, ok := currencies[code]
+
+	if !ok {
+		return "", fmt.Errorf("%w %q", ErrUnknownCurrency, code)
+	}
+
+	region := currencyRegion(code, c)
+
+	if region == "" {
+		return "", fmt.Errorf("money: currency %s has no region", code)
+	}
+
+	return "und-" + region, nil
+}
+
+// currencyRegion returns the region prefix of ISO currency codes, which start
+// with the ISO 3166 code of their region unless they are supranational
+func currencyRegion(code string, c currency) string {
+	if c.IsoNumeric <= 0 || code[0] == 'X' {
+		return ""
+	}
+
+	return code[:2]
+}
+
+// englishLocale reports whether the language of the BCP 47 locale is English
+func englishLocale(locale string) bool {
+	return strings.ToLower(strings.SplitN(strings.Replace(locale, "_", "-", -1), "-", 2)[0]) == "en"
+}
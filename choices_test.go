@@ -0,0 +1,74 @@
+package money
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCurrencyChoices(t *testing.T) {
+	for _, locale := range []string{"en", "en-GB", "en_US", "EN"} {
+		choices, err := CurrencyChoices(locale)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(choices) != len(currencies) {
+			t.Errorf("Expected %d choices but got %d", len(currencies), len(choices))
+		}
+
+		for i := 1; i < len(choices); i++ {
+			if strings.ToLower(choices[i-1].Name) > strings.ToLower(choices[i].Name) {
+				t.Errorf("Expected %s before %s", choices[i].Name, choices[i-1].Name)
+			}
+		}
+	}
+
+	choices, _ := CurrencyChoices("en")
+	regions := map[string]string{"CHF": "CH", "EUR": "EU", "USD": "US", "XAF": "", "BTC": "", "JEP": ""}
+
+	for _, c := range choices {
+		if c.Code == "CHF" && (c.Name != "Swiss Franc" || c.Symbol != "Fr") {
+			t.Errorf("Expected Swiss Franc Fr but got %s %s", c.Name, c.Symbol)
+		}
+
+		if expected, ok := regions[c.Code]; ok && c.Region != expected {
+			t.Errorf("Expected region %q for %s but got %q", expected, c.Code, c.Region)
+		}
+	}
+}
+
+func TestCurrencyChoicesWithDisplayOrder(t *testing.T) {
+	SetCurrencyDisplayOrder([]string{"USD", "EUR"})
+	defer SetCurrencyDisplayOrder(nil)
+
+	choices, _ := CurrencyChoices("en")
+
+	if choices[0].Code != "USD" || choices[1].Code != "EUR" || choices[2].Name > choices[3].Name {
+		t.Errorf("Expected USD, EUR then the other currencies by name but got %v", choices[:4])
+	}
+}
+
+func TestCurrencyChoicesWhenInvalid(t *testing.T) {
+	for _, locale := range []string{"", "de-CH", "fr"} {
+		if _, err := CurrencyChoices(locale); err == nil {
+			t.Errorf("Expected locale %q to be rejected", locale)
+		}
+	}
+}
+
+func TestDefaultLocaleFor(t *testing.T) {
+	values := map[string]string{"chf": "und-CH", "EUR": "und-EU", "USD": "und-US"}
+
+	for code, expected := range values {
+		if locale, err := DefaultLocaleFor(code); err != nil || locale != expected {
+			t.Errorf("Expected %s but got %s (%v)", expected, locale, err)
+		}
+	}
+
+	for _, code := range []string{"XAU", "BTC", "ABC"} {
+		if _, err := DefaultLocaleFor(code); err == nil {
+			t.Errorf("Expected %s to have no default locale", code)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package money
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// MoneySlice is a list of amounts, possibly in several currencies, that prints
+// and totals them readably
+type MoneySlice []Money
+
+// MoneyMap holds amounts by key, e.g. by line item, printing and totalling them
+// readably
+type MoneyMap map[string]Money
+
+// String formats the amounts in order, e.g. "[$1.00 €2,00]"
+func (s MoneySlice) String() string {
+	formatted := make([]string, len(s))
+
+	for i, m := range s {
+		formatted[i] = m.String()
+	}
+
+	return "[" + strings.Join(formatted, " ") + "]"
+}
+
+// Totals sums the amounts per currency
+func (s MoneySlice) Totals() (map[string]Money, error) {
+	return TotalByCurrency(s...)
+}
+
+// Sum returns the total of amounts in a single currency, failing with
+// ErrCurrencyMismatch when they mix currencies
+func (s MoneySlice) Sum() (Money, error) {
+	if len(s) == 0 {
+		return Money{}, nil
+	}
+
+	total := s[0]
+
+	for _, m := range s[1:] {
+		var err error
+
+		if total, err = total.Add(m); err != nil {
+			return Money{}, err
+		}
+	}
+
+	return total, nil
+}
+
+// String formats the amounts ordered by key, e.g. "{fee: $1.00, tax: €2,00}"
+func (m MoneyMap) String() string {
+	keys := m.keys()
+	formatted := make([]string, len(keys))
+
+	for i, k := range keys {
+		formatted[i] = fmt.Sprintf("%s: %s", k, m[k])
+	}
+
+	return "{" + strings.Join(formatted, ", ") + "}"
+}
+
+// Values returns the amounts ordered by key
+func (m MoneyMap) Values() MoneySlice {
+	keys := m.keys()
+	values := make(MoneySlice, len(keys))
+
+	for i, k := range keys {
+		values[i] = m[k]
+	}
+
+	return values
+}
+
+// Totals sums the amounts per currency
+func (m MoneyMap) Totals() (map[string]Money, error) {
+	return m.Values().Totals()
+}
+
+// Sum returns the total of amounts in a single currency, failing with
+// ErrCurrencyMismatch when they mix currencies
+func (m MoneyMap) Sum() (Money, error) {
+	return m.Values().Sum()
+}
+
+// keys returns the sorted keys of m
+func (m MoneyMap) keys() []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
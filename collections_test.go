@@ -0,0 +1,71 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestMoneySlice(t *testing.T) {
+	s := MoneySlice{{100, "USD"}, {250, "EUR"}, {50, "USD"}}
+
+	if result := fmt.Sprintf("%v", s); result != "[$1.00 €2,50 $0.50]" {
+		t.Errorf("Expected [$1.00 €2,50 $0.50] but got %s", result)
+	}
+
+	if result := fmt.Sprintf("%+v", MoneySlice{}); result != "[]" {
+		t.Errorf("Expected [] but got %s", result)
+	}
+
+	totals, err := s.Totals()
+
+	if err != nil || totals["USD"] != (Money{150, "USD"}) || totals["EUR"] != (Money{250, "EUR"}) {
+		t.Errorf("Expected $1.50 and €2,50 but got %v (%v)", totals, err)
+	}
+
+	if _, err := s.Sum(); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+
+	if total, err := s[:1].Sum(); err != nil || total != (Money{100, "USD"}) {
+		t.Errorf("Expected $1.00 but got %s (%v)", total, err)
+	}
+
+	data, _ := json.Marshal(s)
+	expected := `[{"amount":100,"currency":"USD"},{"amount":250,"currency":"EUR"},{"amount":50,"currency":"USD"}]`
+
+	if string(data) != expected {
+		t.Errorf("Expected %s but got %s", expected, data)
+	}
+
+	var decoded MoneySlice
+
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.String() != s.String() {
+		t.Errorf("Expected %s but got %s (%v)", s, decoded, err)
+	}
+}
+
+func TestMoneyMap(t *testing.T) {
+	m := MoneyMap{"tax": {190, "USD"}, "fee": {100, "USD"}, "net": {1000, "USD"}}
+
+	if result := fmt.Sprint(m); result != "{fee: $1.00, net: $10.00, tax: $1.90}" {
+		t.Errorf("Expected {fee: $1.00, net: $10.00, tax: $1.90} but got %s", result)
+	}
+
+	if total, err := m.Sum(); err != nil || total != (Money{1290, "USD"}) {
+		t.Errorf("Expected $12.90 but got %s (%v)", total, err)
+	}
+
+	m["refund"] = Money{-100, "EUR"}
+
+	if totals, err := m.Totals(); err != nil || len(totals) != 2 || totals["EUR"] != (Money{-100, "EUR"}) {
+		t.Errorf("Expected USD and EUR totals but got %v (%v)", totals, err)
+	}
+
+	data, _ := json.Marshal(MoneyMap{"fee": {100, "USD"}})
+
+	if string(data) != `{"fee":{"amount":100,"currency":"USD"}}` {
+		t.Errorf("Expected {\"fee\":{\"amount\":100,\"currency\":\"USD\"}} but got %s", data)
+	}
+}
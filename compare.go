@@ -0,0 +1,27 @@
+package money
+
+// FormatChange is an amount whose rendering differs between two option sets
+type FormatChange struct {
+	Amount Money
+	Old    string
+	New    string
+}
+
+// CompareFormats formats every amount of the corpus with oldOpts and newOpts,
+// returning the amounts whose rendering changes, in corpus order. Formats
+// registered with RegisterFormat let a previous rendering be compared too.
+func CompareFormats(oldOpts, newOpts Options, corpus []Money) []FormatChange {
+	noteDeprecated(oldOpts, newOpts)
+
+	var changes []FormatChange
+
+	for _, m := range corpus {
+		before, after := m.Format(oldOpts), m.Format(newOpts)
+
+		if before != after {
+			changes = append(changes, FormatChange{m, before, after})
+		}
+	}
+
+	return changes
+}
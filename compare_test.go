@@ -0,0 +1,30 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestCompareFormats(t *testing.T) {
+	corpus := []Money{{100, "USD"}, {123456, "USD"}, {123456, "EUR"}, {-50, "JPY"}}
+
+	changes := CompareFormats(Options{}, Options{"with_thousands_separator": false}, corpus)
+
+	expected := []FormatChange{
+		{Money{123456, "USD"}, "$1,234.56", "$1234.56"},
+		{Money{123456, "EUR"}, "€1.234,56", "€1234,56"},
+	}
+
+	if len(changes) != len(expected) {
+		t.Fatalf("Expected %d changes but got %v", len(expected), changes)
+	}
+
+	for i, c := range changes {
+		if c != expected[i] {
+			t.Errorf("Expected %v but got %v", expected[i], c)
+		}
+	}
+
+	if changes := CompareFormats(Options{}, Options{}, corpus); len(changes) != 0 {
+		t.Errorf("Expected no changes but got %v", changes)
+	}
+}
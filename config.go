@@ -0,0 +1,113 @@
+package money
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+// settings holds the application wide overrides of the default options
+var settings struct {
+	sync.RWMutex
+	options Options
+}
+
+// SetDefaults overrides the default options used by Format across the application.
+// Calling it with nil restores the package defaults.
+func SetDefaults(opts Options) error {
+	noteDeprecated(opts)
+
+	if err := validate(opts); err != nil {
+		return err
+	}
+
+	return unlessFrozen(func() error {
+		settings.Lock()
+		defer settings.Unlock()
+
+		settings.options = override(Options{}, opts)
+
+		return nil
+	})
+}
+
+// LoadConfig reads default options from a JSON file and applies them with SetDefaults.
+// The file holds an object with the same keys as Options, e.g.
+//
+//	{"currency": "EUR", "with_symbol_space": true}
+func LoadConfig(path string) error {
+	if Frozen() {
+		return ErrFrozen
+	}
+
+	data, err := os.ReadFile(path)
+
+	if err != nil {
+		return err
+	}
+
+	var opts Options
+
+	if err := json.Unmarshal(data, &opts); err != nil {
+		return fmt.Errorf("money: invalid config %s: %s", path, err)
+	}
+
+	return SetDefaults(opts)
+}
+
+// LoadEnv applies MONEY_DEFAULT_CURRENCY from the environment on top of the current
+// defaults. It is opt-in: call it from an init function or at startup, after LoadConfig
+// if both are used. MONEY_LOCALE and MONEY_ROUNDING are rejected as the package
+// has no locale or rounding settings.
+func LoadEnv() error {
+	if Frozen() {
+		return ErrFrozen
+	}
+
+	for _, name := range []string{"MONEY_LOCALE", "MONEY_ROUNDING"} {
+		if _, ok := os.LookupEnv(name); ok {
+			return fmt.Errorf("money: %s is not supported", name)
+		}
+	}
+
+	code, ok := os.LookupEnv("MONEY_DEFAULT_CURRENCY")
+
+	if !ok {
+		return nil
+	}
+
+	settings.RLock()
+	opts := override(Options{}, settings.options)
+	settings.RUnlock()
+
+	opts["currency"] = strings.ToUpper(strings.TrimSpace(code))
+
+	return SetDefaults(opts)
+}
+
+// validate checks options only use known keys with values of the right type
+func validate(opts Options) error {
+	known := builtins()
+
+	for k, v := range opts {
+		d, ok := known[k]
+
+		if !ok {
+			return fmt.Errorf("money: unknown option %q", k)
+		}
+
+		if fmt.Sprintf("%T", v) != fmt.Sprintf("%T", d) {
+			return fmt.Errorf("money: option %q must be a %T, got %T", k, d, v)
+		}
+	}
+
+	if code, ok := opts["currency"].(string); ok {
+		if _, ok := currencies[code]; !ok {
+			return fmt.Errorf("%w %q", ErrUnknownCurrency, code)
+		}
+	}
+
+	return nil
+}
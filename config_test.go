@@ -0,0 +1,130 @@
+package money
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSetDefaults(t *testing.T) {
+	defer SetDefaults(nil)
+
+	if err := SetDefaults(Options{"currency": "EUR", "with_currency": true}); err != nil {
+		t.Fatalf("Expected defaults to be set but got %s", err)
+	}
+
+	currency := Format(10)
+	expected := "€10,00 EUR"
+
+	if currency != expected {
+		t.Errorf("Expected %s but got %s", expected, currency)
+	}
+
+	SetDefaults(nil)
+
+	if currency := Format(10); currency != "$10.00" {
+		t.Errorf("Expected defaults to be restored but got %s", currency)
+	}
+}
+
+func TestSetDefaultsWhenInvalid(t *testing.T) {
+	values := []Options{
+		{"currency": "XYZ"},
+		{"currency": 10},
+		{"with_cents": "yes"},
+		{"rounding": "half_even"},
+	}
+
+	for _, value := range values {
+		if err := SetDefaults(value); err == nil {
+			t.Errorf("Expected %v to be rejected", value)
+		}
+	}
+
+	if err := SetDefaults(Options{"currency": "XYZ"}); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+
+	if currency := Format(10); currency != "$10.00" {
+		t.Errorf("Expected defaults to be unchanged but got %s", currency)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	defer SetDefaults(nil)
+
+	path := filepath.Join(t.TempDir(), "money.json")
+
+	if err := os.WriteFile(path, []byte(`{"currency": "GBP", "with_cents": false}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := LoadConfig(path); err != nil {
+		t.Fatalf("Expected config to load but got %s", err)
+	}
+
+	if currency := Format(10); currency != "£10" {
+		t.Errorf("Expected £10 but got %s", currency)
+	}
+}
+
+func TestLoadConfigWhenMalformed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "money.json")
+
+	if err := os.WriteFile(path, []byte(`{"currency": `), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := LoadConfig(path); err == nil {
+		t.Error("Expected malformed config to be rejected")
+	}
+
+	if err := LoadConfig(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("Expected missing config to be rejected")
+	}
+}
+
+func TestLoadEnv(t *testing.T) {
+	defer SetDefaults(nil)
+
+	SetDefaults(Options{"with_cents": false})
+	t.Setenv("MONEY_DEFAULT_CURRENCY", "eur")
+
+	if err := LoadEnv(); err != nil {
+		t.Fatalf("Expected environment to load but got %s", err)
+	}
+
+	if currency := Format(10); currency != "€10" {
+		t.Errorf("Expected €10 but got %s", currency)
+	}
+}
+
+func TestLoadEnvWhenUnset(t *testing.T) {
+	defer SetDefaults(nil)
+
+	if err := LoadEnv(); err != nil {
+		t.Fatalf("Expected no error but got %s", err)
+	}
+
+	if currency := Format(10); currency != "$10.00" {
+		t.Errorf("Expected defaults to be unchanged but got %s", currency)
+	}
+}
+
+func TestLoadEnvWhenInvalid(t *testing.T) {
+	defer SetDefaults(nil)
+
+	t.Setenv("MONEY_DEFAULT_CURRENCY", "XYZ")
+
+	if err := LoadEnv(); err == nil {
+		t.Error("Expected unknown currency to be rejected")
+	}
+
+	t.Setenv("MONEY_DEFAULT_CURRENCY", "EUR")
+	t.Setenv("MONEY_ROUNDING", "half_even")
+
+	if err := LoadEnv(); err == nil {
+		t.Error("Expected MONEY_ROUNDING to be rejected")
+	}
+}
@@ -0,0 +1,80 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// ControlSum returns the exact sum of decimal amount fields, as required in the
+// control records of batch payment files (e.g. CtrlSum in ISO 20022 messages).
+// Fields and the result use "." as decimal mark without grouping, and may not
+// have more fraction digits than the currency sub unit.
+func ControlSum(code string, fields ...string) (string, error) {
+	code = strings.ToUpper(code)
+	c, ok := currencies[code]
+
+	if !ok {
+		return "", fmt.Errorf("%w %q", ErrUnknownCurrency, code)
+	}
+
+	digits := c.exponent()
+
+	var sum int64
+
+	for _, field := range fields {
+		units, err := parseUnits(field, digits)
+
+		if err != nil {
+			return "", err
+		}
+
+		if units > 0 && sum > math.MaxInt64-units || units < 0 && sum < math.MinInt64-units {
+			return "", fmt.Errorf("%w: control sum of %s amounts", ErrOverflow, code)
+		}
+
+		sum += units
+	}
+
+	return formatUnits(sum, digits), nil
+}
+
+// VerifyControlSum checks sum is the control sum of the amount fields
+func VerifyControlSum(sum, code string, fields ...string) error {
+	expected, err := ControlSum(code, fields...)
+
+	if err != nil {
+		return err
+	}
+
+	code = strings.ToUpper(code)
+
+	actual, err := parseUnits(sum, currencies[code].exponent())
+
+	if err != nil {
+		return err
+	}
+
+	if formatUnits(actual, currencies[code].exponent()) != expected {
+		return fmt.Errorf("money: control sum %s does not match %s", sum, expected)
+	}
+
+	return nil
+}
+
+// formatUnits formats minor units as a plain decimal with the given exponent
+func formatUnits(units int64, digits int) string {
+	var sign string
+
+	if units < 0 {
+		sign = "-"
+	}
+
+	s := fmt.Sprintf("%0*d", digits+1, absUnits(units))
+
+	if digits == 0 {
+		return sign + s
+	}
+
+	return sign + s[:len(s)-digits] + "." + s[len(s)-digits:]
+}
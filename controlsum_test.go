@@ -0,0 +1,86 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestControlSum(t *testing.T) {
+	sum, err := ControlSum("EUR", "1234.56", "0.44", "10", "-5.5")
+
+	if err != nil {
+		t.Fatalf("Expected control sum but got %s", err)
+	}
+
+	if sum != "1239.50" {
+		t.Errorf("Expected 1239.50 but got %s", sum)
+	}
+
+	if sum, _ := ControlSum("JPY", "1000", "250"); sum != "1250" {
+		t.Errorf("Expected 1250 but got %s", sum)
+	}
+
+	if sum, _ := ControlSum("BHD", "0.005", "1.1"); sum != "1.105" {
+		t.Errorf("Expected 1.105 but got %s", sum)
+	}
+
+	if sum, _ := ControlSum("USD"); sum != "0.00" {
+		t.Errorf("Expected 0.00 but got %s", sum)
+	}
+
+	if sum, err := ControlSum("usd", "1.5", "2"); err != nil || sum != "3.50" {
+		t.Errorf("Expected 3.50 but got %s (%v)", sum, err)
+	}
+}
+
+func TestControlSumWhenInvalid(t *testing.T) {
+	values := map[string]int{
+		"1,234.56": 1,
+		"12.345":   5,
+		"12.":      3,
+		".5":       0,
+		"-":        1,
+		"1e3":      1,
+	}
+
+	for value, pos := range values {
+		_, err := ControlSum("EUR", "1.00", value)
+
+		var perr *ParseError
+
+		if !errors.As(err, &perr) {
+			t.Errorf("Expected %q to fail with a ParseError but got %v", value, err)
+			continue
+		}
+
+		if perr.Pos != pos {
+			t.Errorf("Expected %q to fail at %d but got %d", value, pos, perr.Pos)
+		}
+	}
+
+	if _, err := ControlSum("XYZ", "1.00"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+
+	if _, err := ControlSum("EUR", "92233720368547758.07", "0.01"); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow but got %v", err)
+	}
+
+	if _, err := ControlSum("EUR", "92233720368547758.08"); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow but got %v", err)
+	}
+}
+
+func TestVerifyControlSum(t *testing.T) {
+	if err := VerifyControlSum("1235", "EUR", "1234.56", "0.44"); err != nil {
+		t.Errorf("Expected control sum to match but got %s", err)
+	}
+
+	if err := VerifyControlSum("1235", "eur", "1234.56", "0.44"); err != nil {
+		t.Errorf("Expected control sum to match but got %s", err)
+	}
+
+	if err := VerifyControlSum("1235.01", "EUR", "1234.56", "0.44"); err == nil {
+		t.Error("Expected control sum not to match")
+	}
+}
@@ -0,0 +1,98 @@
+package money
+
+import (
+	"fmt"
+	"time"
+)
+
+// Credit is the balance of a gift card or of store credit, locked to the
+// currency it was issued in and redeemable until it expires. It is not safe for
+// concurrent use.
+type Credit struct {
+	balance Money
+	expires time.Time
+	now     func() time.Time
+}
+
+// NewCredit returns a Credit of balance expiring at expires, or never when
+// expires is zero, failing for negative balances and unknown currencies
+func NewCredit(balance Money, expires time.Time) (*Credit, error) {
+	if _, ok := currencies[balance.currency]; !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownCurrency, balance.currency)
+	}
+
+	if balance.IsNegative() {
+		return nil, fmt.Errorf("money: invalid credit balance %s", balance)
+	}
+
+	return &Credit{balance, expires, time.Now}, nil
+}
+
+// Balance returns the balance left
+func (c *Credit) Balance() Money {
+	return c.balance
+}
+
+// Expires returns the time the credit expires, zero if never
+func (c *Credit) Expires() time.Time {
+	return c.expires
+}
+
+// Expired reports whether the credit has expired
+func (c *Credit) Expired() bool {
+	return !c.expires.IsZero() && !c.now().Before(c.expires)
+}
+
+// Redeem deducts amount from the balance, returning the balance left. It fails
+// with ErrCurrencyMismatch for amounts in another currency, ErrExpired once the
+// credit has expired and ErrInsufficientBalance for amounts exceeding the
+// balance, leaving the balance as it was.
+func (c *Credit) Redeem(amount Money) (Money, error) {
+	if err := c.balance.sameCurrency(amount); err != nil {
+		return c.balance, err
+	}
+
+	if !amount.IsPositive() {
+		return c.balance, fmt.Errorf("money: cannot redeem %s", amount)
+	}
+
+	if c.Expired() {
+		return c.balance, fmt.Errorf("%w on %s", ErrExpired, c.expires.Format(time.RFC3339))
+	}
+
+	if amount.amount > c.balance.amount {
+		return c.balance, fmt.Errorf("%w: %s exceeds %s", ErrInsufficientBalance, amount, c.balance)
+	}
+
+	c.balance.amount -= amount.amount
+
+	return c.balance, nil
+}
+
+// RedeemUpTo deducts as much of amount as the balance covers, returning the
+// amount redeemed and the amount left to pay by other means, e.g. when a gift
+// card pays part of an order. It fails as Redeem does, except for amounts
+// exceeding the balance.
+func (c *Credit) RedeemUpTo(amount Money) (redeemed, due Money, err error) {
+	if err := c.balance.sameCurrency(amount); err != nil {
+		return Money{}, Money{}, err
+	}
+
+	if amount.IsNegative() {
+		return Money{}, Money{}, fmt.Errorf("money: cannot redeem %s", amount)
+	}
+
+	if c.Expired() {
+		return Money{}, Money{}, fmt.Errorf("%w on %s", ErrExpired, c.expires.Format(time.RFC3339))
+	}
+
+	redeemed = amount
+
+	if amount.amount > c.balance.amount {
+		redeemed.amount = c.balance.amount
+	}
+
+	c.balance.amount -= redeemed.amount
+
+	return redeemed, Money{amount.amount - redeemed.amount, amount.currency}, nil
+}
@@ -0,0 +1,65 @@
+package money
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCredit(t *testing.T) {
+	issued := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	c, err := NewCredit(Money{5000, "EUR"}, issued.AddDate(1, 0, 0))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clock := issued
+	c.now = func() time.Time { return clock }
+
+	if left, err := c.Redeem(Money{1250, "EUR"}); err != nil || left != (Money{3750, "EUR"}) {
+		t.Errorf("Expected €37,50 left but got %s %v", left, err)
+	}
+
+	if left, err := c.Redeem(Money{4000, "EUR"}); !errors.Is(err, ErrInsufficientBalance) || left != (Money{3750, "EUR"}) {
+		t.Errorf("Expected ErrInsufficientBalance leaving €37,50 but got %s %v", left, err)
+	}
+
+	if _, err := c.Redeem(Money{100, "USD"}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+
+	if _, err := c.Redeem(Money{0, "EUR"}); err == nil {
+		t.Error("Expected a zero redemption to be rejected")
+	}
+
+	redeemed, due, err := c.RedeemUpTo(Money{5000, "EUR"})
+
+	if err != nil || redeemed != (Money{3750, "EUR"}) || due != (Money{1250, "EUR"}) || !c.Balance().IsZero() {
+		t.Errorf("Expected €37,50 redeemed and €12,50 due but got %s %s %v", redeemed, due, err)
+	}
+
+	clock = c.Expires()
+
+	if _, err := c.Redeem(Money{1, "EUR"}); !errors.Is(err, ErrExpired) || !c.Expired() {
+		t.Errorf("Expected ErrExpired but got %v", err)
+	}
+
+	if _, _, err := c.RedeemUpTo(Money{1, "EUR"}); !errors.Is(err, ErrExpired) {
+		t.Errorf("Expected ErrExpired but got %v", err)
+	}
+}
+
+func TestNewCreditWhenInvalid(t *testing.T) {
+	if _, err := NewCredit(Money{-1, "EUR"}, time.Time{}); err == nil {
+		t.Error("Expected a negative balance to be rejected")
+	}
+
+	if _, err := NewCredit(Money{}, time.Time{}); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+
+	if c, _ := NewCredit(Money{100, "EUR"}, time.Time{}); c.Expired() {
+		t.Error("Expected a credit without expiry never to expire")
+	}
+}
@@ -0,0 +1,54 @@
+package money
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// CSVWriter writes records of formatted amounts as CSV, quoting any amount
+// containing the delimiter, such as "1.234,56" in a comma separated file
+type CSVWriter struct {
+	w    *csv.Writer
+	opts Options
+}
+
+// NewCSVWriter returns a CSVWriter separating fields with comma and formatting amounts with opts
+func NewCSVWriter(w io.Writer, comma rune, opts ...Options) *CSVWriter {
+	writer := csv.NewWriter(w)
+	writer.Comma = comma
+
+	options := Options{}
+
+	if len(opts) > 0 {
+		noteDeprecated(opts[0])
+		options = opts[0]
+	}
+
+	return &CSVWriter{writer, options}
+}
+
+// Write writes one record made of fields followed by the formatted amounts
+func (w *CSVWriter) Write(fields []string, amounts ...float64) (err error) {
+	defer guard(&err)
+
+	record := make([]string, 0, len(fields)+len(amounts))
+	record = append(record, fields...)
+
+	options := override(defaults(), w.opts)
+
+	for _, amount := range amounts {
+		if err := exactFloat(amount, options["currency"].(string)); err != nil {
+			return err
+		}
+
+		record = append(record, formatFloat(amount, override(Options{}, options)))
+	}
+
+	return w.w.Write(record)
+}
+
+// Flush writes any buffered records to the underlying writer
+func (w *CSVWriter) Flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
@@ -0,0 +1,52 @@
+package money
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCSVWriter(t *testing.T) {
+	var b bytes.Buffer
+
+	w := NewCSVWriter(&b, ',', Options{"currency": "EUR"})
+	w.Write([]string{"rent"}, 1234.56, 10)
+	w.Write([]string{"fee"}, 0.5)
+
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "rent,\"€1.234,56\",\"€10,00\"\nfee,\"€0,50\"\n"
+
+	if b.String() != expected {
+		t.Errorf("Expected %q but got %q", expected, b.String())
+	}
+}
+
+func TestCSVWriterWhenSemicolon(t *testing.T) {
+	var b bytes.Buffer
+
+	w := NewCSVWriter(&b, ';', Options{"currency": "EUR"})
+	w.Write([]string{"rent"}, 1234.56)
+	w.Flush()
+
+	expected := "rent;€1.234,56\n"
+
+	if b.String() != expected {
+		t.Errorf("Expected %q but got %q", expected, b.String())
+	}
+}
+
+func TestCSVWriterWithDefaults(t *testing.T) {
+	var b bytes.Buffer
+
+	w := NewCSVWriter(&b, ',')
+	w.Write(nil, 1234.56)
+	w.Flush()
+
+	expected := "\"$1,234.56\"\n"
+
+	if b.String() != expected {
+		t.Errorf("Expected %q but got %q", expected, b.String())
+	}
+}
@@ -1,5 +1,9 @@
 package money
 
+import (
+	"math"
+)
+
 type currency struct {
 	IsoNumeric         int
 	Name               string
@@ -13,6 +17,24 @@ type currency struct {
 	HTMLEntity         string
 }
 
+// exponent returns the number of decimal digits of the currency sub unit
+func (c currency) exponent() int {
+	if c.SubUnit == "" || c.SubUnitToUnit <= 1 {
+		return 0
+	}
+
+	return int(math.Ceil(math.Log10(float64(c.SubUnitToUnit))))
+}
+
+// units returns the number of minor units in one unit of the currency
+func (c currency) units() int64 {
+	if c.SubUnit == "" || c.SubUnitToUnit <= 1 {
+		return 1
+	}
+
+	return c.SubUnitToUnit
+}
+
 var currencies = map[string]currency{
 	"AED": currency{784, "United Arab Emirates Dirham", "د.إ", true, []string{"DH", "Dhs"}, ",", ".", "Fils", 100, ""},
 	"AFN": currency{971, "Afghan Afghani", "؋", false, []string{"Af", "Afs"}, ",", ".", "Pul", 100, ""},
@@ -0,0 +1,170 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// CustomsRule holds the import duty and tax rules of a country
+type CustomsRule struct {
+	// Country is the ISO 3166 country code, e.g. "DE"
+	Country string
+
+	// Currency is the ISO code customs values are assessed in
+	Currency string
+
+	// DutyRate is the duty on the customs value, e.g. 0.12 for 12%
+	DutyRate float64
+
+	// DutyDeMinimis is the customs value in minor units up to which no duty is
+	// due, 0 for none
+	DutyDeMinimis int64
+
+	// TaxRate is the import VAT, GST or sales tax rate
+	TaxRate float64
+
+	// TaxDeMinimis is the customs value in minor units up to which no import tax
+	// is due, 0 for none
+	TaxDeMinimis int64
+
+	// ShippingIncluded assesses goods with their shipping, the CIF value, rather
+	// than alone, the FOB value
+	ShippingIncluded bool
+
+	// TaxIncludesDuty levies import tax on the customs value plus duty
+	TaxIncludesDuty bool
+}
+
+// CustomsTable looks up the customs rule of a country
+type CustomsTable interface {
+	CustomsRule(country string) (CustomsRule, bool)
+}
+
+// CustomsRules is a CustomsTable of rules by country code
+type CustomsRules map[string]CustomsRule
+
+// CustomsRule returns the rule of the country code, in any case
+func (r CustomsRules) CustomsRule(country string) (CustomsRule, bool) {
+	rule, ok := r[strings.ToUpper(country)]
+	return rule, ok
+}
+
+// Customs estimates the duty and import tax of cross border orders, from the
+// rules of a table
+type Customs struct {
+	// Table holds the rules by country
+	Table CustomsTable
+
+	// Converter, when set, converts orders to the currency of the rule
+	Converter Converter
+}
+
+// CustomsEstimate is the customs value of an order and the charges due on it
+type CustomsEstimate struct {
+	CustomsValue Money
+	Duty         Money
+	ImportTax    Money
+	Charges      Money
+}
+
+// Estimate returns the duty and import tax due on goods shipped for shipping to
+// country, in the currency of its rule. It fails for countries the table has no
+// rule for, invalid rules and negative amounts.
+func (c Customs) Estimate(goods, shipping Money, country string) (CustomsEstimate, error) {
+	if c.Table == nil {
+		return CustomsEstimate{}, fmt.Errorf("money: customs requires a table")
+	}
+
+	rule, ok := c.Table.CustomsRule(country)
+
+	if !ok {
+		return CustomsEstimate{}, fmt.Errorf("money: no customs rule for %q", country)
+	}
+
+	if err := rule.validate(); err != nil {
+		return CustomsEstimate{}, err
+	}
+
+	code := strings.ToUpper(rule.Currency)
+	value, err := c.convert(goods, code)
+
+	if err != nil {
+		return CustomsEstimate{}, err
+	}
+
+	if rule.ShippingIncluded {
+		if shipping, err = c.convert(shipping, code); err != nil {
+			return CustomsEstimate{}, err
+		}
+
+		if value, err = value.Add(shipping); err != nil {
+			return CustomsEstimate{}, err
+		}
+	}
+
+	e := CustomsEstimate{value, Money{0, code}, Money{0, code}, Money{0, code}}
+
+	if value.amount > rule.DutyDeMinimis {
+		if e.Duty, err = value.mulRate(rule.DutyRate); err != nil {
+			return CustomsEstimate{}, err
+		}
+	}
+
+	if value.amount > rule.TaxDeMinimis {
+		base := value
+
+		if rule.TaxIncludesDuty {
+			if base, err = base.Add(e.Duty); err != nil {
+				return CustomsEstimate{}, err
+			}
+		}
+
+		if e.ImportTax, err = base.mulRate(rule.TaxRate); err != nil {
+			return CustomsEstimate{}, err
+		}
+	}
+
+	if e.Charges, err = e.Duty.Add(e.ImportTax); err != nil {
+		return CustomsEstimate{}, err
+	}
+
+	return e, nil
+}
+
+// convert converts m to the currency code, failing for negative amounts
+func (c Customs) convert(m Money, code string) (Money, error) {
+	if m.IsNegative() {
+		return Money{}, fmt.Errorf("money: invalid customs value %s", m)
+	}
+
+	if m.currency == code {
+		return m, nil
+	}
+
+	if c.Converter == nil {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.currency, code)
+	}
+
+	return c.Converter.ConvertMoney(m, code)
+}
+
+// validate fails when the rule has an unknown currency, invalid rates or
+// negative thresholds
+func (r CustomsRule) validate() error {
+	if _, ok := currencies[strings.ToUpper(r.Currency)]; !ok {
+		return fmt.Errorf("%w %q in the customs rule of %s", ErrUnknownCurrency, r.Currency, r.Country)
+	}
+
+	for _, rate := range []float64{r.DutyRate, r.TaxRate} {
+		if !(rate >= 0) || math.IsInf(rate, 0) {
+			return fmt.Errorf("money: customs rule of %s has an invalid rate %v", r.Country, rate)
+		}
+	}
+
+	if r.DutyDeMinimis < 0 || r.TaxDeMinimis < 0 {
+		return fmt.Errorf("money: customs rule of %s has a negative threshold", r.Country)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+var testCustomsRules = CustomsRules{
+	"DE": {Country: "DE", Currency: "EUR", DutyRate: 0.12, DutyDeMinimis: 15000, TaxRate: 0.19, ShippingIncluded: true, TaxIncludesDuty: true},
+	"US": {Country: "US", Currency: "USD", DutyRate: 0.05, DutyDeMinimis: 80000, TaxDeMinimis: math.MaxInt64},
+	"AU": {Country: "AU", Currency: "AUD", DutyRate: 0.05, DutyDeMinimis: 100000, TaxRate: 0.1},
+}
+
+func TestCustomsEstimate(t *testing.T) {
+	customs := Customs{Table: testCustomsRules}
+
+	values := []struct {
+		goods, shipping Money
+		country         string
+		expected        CustomsEstimate
+	}{
+		{Money{10000, "EUR"}, Money{1000, "EUR"}, "DE", CustomsEstimate{Money{11000, "EUR"}, Money{0, "EUR"}, Money{2090, "EUR"}, Money{2090, "EUR"}}},
+		{Money{20000, "EUR"}, Money{1000, "EUR"}, "de", CustomsEstimate{Money{21000, "EUR"}, Money{2520, "EUR"}, Money{4469, "EUR"}, Money{6989, "EUR"}}},
+		{Money{80000, "USD"}, Money{2500, "USD"}, "US", CustomsEstimate{Money{80000, "USD"}, Money{0, "USD"}, Money{0, "USD"}, Money{0, "USD"}}},
+		{Money{80001, "USD"}, Money{2500, "USD"}, "US", CustomsEstimate{Money{80001, "USD"}, Money{4000, "USD"}, Money{0, "USD"}, Money{4000, "USD"}}},
+		{Money{120000, "AUD"}, Money{0, "AUD"}, "AU", CustomsEstimate{Money{120000, "AUD"}, Money{6000, "AUD"}, Money{12000, "AUD"}, Money{18000, "AUD"}}},
+	}
+
+	for _, v := range values {
+		if e, err := customs.Estimate(v.goods, v.shipping, v.country); err != nil || e != v.expected {
+			t.Errorf("Expected %v for %s to %s but got %v %v", v.expected, v.goods, v.country, e, err)
+		}
+	}
+}
+
+func TestCustomsEstimateConverts(t *testing.T) {
+	customs := Customs{testCustomsRules, ConverterFunc(func(m Money, to string) (Money, error) {
+		return Money{m.amount * 2, to}, nil
+	})}
+
+	e, err := customs.Estimate(Money{10000, "USD"}, Money{500, "USD"}, "DE")
+
+	if err != nil || e.CustomsValue != (Money{21000, "EUR"}) || e.Duty != (Money{2520, "EUR"}) {
+		t.Errorf("Expected a customs value of €210,00 with €25,20 duty but got %v %v", e, err)
+	}
+}
+
+func TestCustomsEstimateWhenInvalid(t *testing.T) {
+	customs := Customs{Table: testCustomsRules}
+
+	if _, err := customs.Estimate(Money{100, "USD"}, Money{0, "USD"}, "FR"); err == nil {
+		t.Error("Expected a country without a rule to be rejected")
+	}
+
+	if _, err := customs.Estimate(Money{100, "USD"}, Money{0, "EUR"}, "DE"); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+
+	if _, err := customs.Estimate(Money{-100, "EUR"}, Money{0, "EUR"}, "DE"); err == nil {
+		t.Error("Expected negative goods to be rejected")
+	}
+
+	if _, err := (Customs{}).Estimate(Money{100, "EUR"}, Money{0, "EUR"}, "DE"); err == nil {
+		t.Error("Expected customs without a table to be rejected")
+	}
+
+	invalid := Customs{Table: CustomsRules{"XX": {Country: "XX", Currency: "EUR", DutyRate: math.NaN()}}}
+
+	if _, err := invalid.Estimate(Money{100, "EUR"}, Money{0, "EUR"}, "XX"); err == nil {
+		t.Error("Expected an invalid rule to be rejected")
+	}
+}
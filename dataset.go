@@ -0,0 +1,98 @@
+package money
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+var dataset struct {
+	sync.RWMutex
+	frozen bool
+}
+
+// DatasetChecksum returns the SHA-256 checksum, in hex, of the currency registry
+// and the registered jurisdictions and changeovers
+func DatasetChecksum() string {
+	h := sha256.New()
+
+	codes := make([]string, 0, len(currencies))
+
+	for code := range currencies {
+		codes = append(codes, code)
+	}
+
+	sort.Strings(codes)
+
+	for _, code := range codes {
+		fmt.Fprintf(h, "currency %s %#v\n", code, currencies[code])
+	}
+
+	jurisdictions.RLock()
+
+	codes = codes[:0]
+
+	for code := range jurisdictions.rules {
+		codes = append(codes, code)
+	}
+
+	sort.Strings(codes)
+
+	for _, code := range codes {
+		fmt.Fprintf(h, "jurisdiction %#v\n", jurisdictions.rules[code])
+	}
+
+	jurisdictions.RUnlock()
+
+	changeovers.RLock()
+
+	for _, c := range changeovers.list {
+		fmt.Fprintf(h, "changeover %#v\n", c)
+	}
+
+	changeovers.RUnlock()
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Freeze verifies the dataset against checksum, as returned by DatasetChecksum,
+// and then makes it immutable: registering formats, jurisdictions or changeovers,
+// setting a symbol resolver and every other package wide setting, such as
+// SetDefaults, LoadConfig, LoadEnv, ImportConfig, SetCurrencyDisplayOrder,
+// SetStrictFloats, SetPanicFree, SetDeprecationLogger and SetMetrics, fail with
+// ErrFrozen from then on
+func Freeze(checksum string) error {
+	dataset.Lock()
+	defer dataset.Unlock()
+
+	if sum := DatasetChecksum(); sum != checksum {
+		return fmt.Errorf("money: dataset checksum %s does not match %s", sum, checksum)
+	}
+
+	dataset.frozen = true
+
+	return nil
+}
+
+// Frozen reports whether Freeze made the dataset immutable
+func Frozen() bool {
+	dataset.RLock()
+	defer dataset.RUnlock()
+
+	return dataset.frozen
+}
+
+// unlessFrozen runs the registration or setting fn, failing with ErrFrozen once
+// the dataset is frozen. Freeze waits for those in progress.
+func unlessFrozen(fn func() error) error {
+	dataset.RLock()
+	defer dataset.RUnlock()
+
+	if dataset.frozen {
+		return ErrFrozen
+	}
+
+	return fn()
+}
@@ -0,0 +1,55 @@
+package money
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDatasetChecksum(t *testing.T) {
+	sum := DatasetChecksum()
+
+	if len(sum) != 64 || sum != DatasetChecksum() {
+		t.Errorf("Expected a stable SHA-256 checksum but got %s", sum)
+	}
+
+	RegisterChangeover(Changeover{"LTL", "USD", 3, time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(1991, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	if DatasetChecksum() == sum {
+		t.Error("Expected a registration to change the checksum")
+	}
+}
+
+func TestFreeze(t *testing.T) {
+	defer func() { dataset.frozen = false }()
+
+	if err := Freeze("0000"); err == nil || Frozen() {
+		t.Fatal("Expected a wrong checksum to fail")
+	}
+
+	if err := Freeze(DatasetChecksum()); err != nil || !Frozen() {
+		t.Fatalf("Expected the dataset to freeze but got %v", err)
+	}
+
+	attempts := map[string]error{
+		"format":       RegisterFormat("frozen", func(float64, Options) string { return "" }),
+		"jurisdiction": RegisterJurisdiction(Jurisdiction{Code: "FROZEN", Currency: "EUR"}),
+		"changeover":   RegisterChangeover(Changeover{"LTL", "EUR", 3.4528, time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC), time.Time{}}),
+		"resolver":     SetSymbolResolver(nil),
+		"defaults":     SetDefaults(nil),
+		"config":       LoadConfig("testdata/missing.json"),
+		"env":          LoadEnv(),
+		"import":       ImportConfig(ExportConfig()),
+		"order":        SetCurrencyDisplayOrder(nil),
+		"floats":       SetStrictFloats(false, nil),
+		"panic free":   SetPanicFree(false, nil),
+		"deprecations": SetDeprecationLogger(nil),
+		"metrics":      SetMetrics(nil),
+	}
+
+	for name, err := range attempts {
+		if !errors.Is(err, ErrFrozen) {
+			t.Errorf("Expected %s to fail with ErrFrozen but got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+)
+
+// DayCount is the convention interest accrues by, counting the days of a period
+// and the days of a year
+type DayCount int
+
+const (
+	// ACT360 counts actual days over a 360 day year, as money markets do
+	ACT360 DayCount = iota
+
+	// ACT365 counts actual days over a 365 day year, leap years included
+	ACT365
+
+	// Thirty360 counts 30 day months over a 360 day year, by the ISDA bond basis:
+	// a 31st is the 30th, and an end on the 31st is the 30th when the start is
+	Thirty360
+)
+
+var dayCountNames = []string{"ACT/360", "ACT/365", "30/360"}
+
+// String returns the name of the convention, e.g. "ACT/360"
+func (c DayCount) String() string {
+	if c < 0 || int(c) >= len(dayCountNames) {
+		return fmt.Sprintf("DayCount(%d)", int(c))
+	}
+
+	return dayCountNames[c]
+}
+
+// Days returns the days from the day of from to the day of to under the
+// convention, negative when to is before from
+func (c DayCount) Days(from, to time.Time) int64 {
+	from, to = civilDay(from), civilDay(to)
+
+	if c != Thirty360 {
+		return int64(to.Sub(from).Hours() / 24)
+	}
+
+	y1, m1, d1 := from.Date()
+	y2, m2, d2 := to.Date()
+
+	if d1 == 31 {
+		d1 = 30
+	}
+
+	if d2 == 31 && d1 == 30 {
+		d2 = 30
+	}
+
+	return 360*int64(y2-y1) + 30*int64(m2-m1) + int64(d2-d1)
+}
+
+// YearFraction returns the fraction of a year from the day of from to the day of
+// to under the convention
+func (c DayCount) YearFraction(from, to time.Time) float64 {
+	f, _ := c.yearFraction(from, to).Float64()
+	return f
+}
+
+// yearFraction returns the exact fraction of a year from from to to
+func (c DayCount) yearFraction(from, to time.Time) *big.Rat {
+	basis := int64(360)
+
+	if c == ACT365 {
+		basis = 365
+	}
+
+	return big.NewRat(c.Days(from, to), basis)
+}
+
+// AccruedInterest returns the simple interest principal accrues at the annual
+// rate, e.g. 0.05 for 5%, from the day of from to the day of to under the
+// convention, computed exactly and rounded half away from zero to minor units
+func AccruedInterest(principal Money, rate float64, from, to time.Time, convention DayCount) (Money, error) {
+	if _, ok := currencies[principal.currency]; !ok {
+		return Money{}, fmt.Errorf("%w %q", ErrUnknownCurrency, principal.currency)
+	}
+
+	if convention < ACT360 || convention > Thirty360 {
+		return Money{}, fmt.Errorf("money: invalid day count %s", convention)
+	}
+
+	if math.IsNaN(rate) || math.IsInf(rate, 0) {
+		return Money{}, fmt.Errorf("money: invalid interest rate %v", rate)
+	}
+
+	if civilDay(to).Before(civilDay(from)) {
+		return Money{}, fmt.Errorf("money: cannot accrue interest from %s back to %s", from.Format("2006-01-02"), to.Format("2006-01-02"))
+	}
+
+	interest := new(big.Rat).SetInt64(principal.amount)
+	interest.Mul(interest, decimalRat(rate))
+	units, ok := roundRat(interest.Mul(interest, convention.yearFraction(from, to)))
+
+	if !ok {
+		return Money{}, fmt.Errorf("%w: interest on %s at %v", ErrOverflow, principal, rate)
+	}
+
+	return Money{units, principal.currency}, nil
+}
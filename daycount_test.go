@@ -0,0 +1,86 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func dayCountDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestDayCountDays(t *testing.T) {
+	values := []struct {
+		convention DayCount
+		from, to   time.Time
+		expected   int64
+	}{
+		{ACT360, dayCountDate(2024, 1, 31), dayCountDate(2024, 3, 1), 30},
+		{ACT365, dayCountDate(2024, 1, 1), dayCountDate(2025, 1, 1), 366},
+		{Thirty360, dayCountDate(2024, 1, 31), dayCountDate(2024, 3, 1), 31},
+		{Thirty360, dayCountDate(2024, 1, 30), dayCountDate(2024, 3, 31), 60},
+		{Thirty360, dayCountDate(2024, 1, 15), dayCountDate(2024, 3, 31), 76},
+		{Thirty360, dayCountDate(2024, 1, 1), dayCountDate(2025, 1, 1), 360},
+		{ACT360, dayCountDate(2024, 3, 1), dayCountDate(2024, 2, 1), -29},
+	}
+
+	for _, v := range values {
+		if days := v.convention.Days(v.from, v.to); days != v.expected {
+			t.Errorf("Expected %d days %s but got %d", v.expected, v.convention, days)
+		}
+	}
+
+	if f := ACT360.YearFraction(dayCountDate(2024, 1, 1), dayCountDate(2024, 4, 1)); math.Abs(f-91.0/360) > 1e-12 {
+		t.Errorf("Expected 91/360 but got %v", f)
+	}
+}
+
+func TestAccruedInterest(t *testing.T) {
+	principal := Money{100000000, "USD"}
+	from, to := dayCountDate(2024, 1, 15), dayCountDate(2024, 7, 15)
+
+	values := []struct {
+		convention DayCount
+		expected   Money
+	}{
+		{ACT360, Money{2527778, "USD"}},
+		{ACT365, Money{2493151, "USD"}},
+		{Thirty360, Money{2500000, "USD"}},
+	}
+
+	for _, v := range values {
+		if interest, err := AccruedInterest(principal, 0.05, from, to, v.convention); err != nil || interest != v.expected {
+			t.Errorf("Expected %s %s but got %s %v", v.expected, v.convention, interest, err)
+		}
+	}
+
+	if interest, err := AccruedInterest(principal, 0.05, from, from, ACT360); err != nil || interest != (Money{0, "USD"}) {
+		t.Errorf("Expected no interest over no days but got %s %v", interest, err)
+	}
+
+	if s := Thirty360.String(); s != "30/360" {
+		t.Errorf("Expected 30/360 but got %s", s)
+	}
+}
+
+func TestAccruedInterestWhenInvalid(t *testing.T) {
+	from, to := dayCountDate(2024, 1, 15), dayCountDate(2024, 7, 15)
+
+	if _, err := AccruedInterest(Money{100, "USD"}, 0.05, to, from, ACT360); err == nil {
+		t.Error("Expected a period going back to be rejected")
+	}
+
+	if _, err := AccruedInterest(Money{100, "USD"}, math.NaN(), from, to, ACT360); err == nil {
+		t.Error("Expected a NaN rate to be rejected")
+	}
+
+	if _, err := AccruedInterest(Money{100, "USD"}, 0.05, from, to, DayCount(9)); err == nil {
+		t.Error("Expected an invalid day count to be rejected")
+	}
+
+	if _, err := AccruedInterest(Money{math.MaxInt64, "USD"}, 1000, from, to, ACT360); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow but got %v", err)
+	}
+}
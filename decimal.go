@@ -0,0 +1,244 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal is the part of a decimal number type that Money converts from, met by
+// shopspring/decimal's Decimal and apd's *Decimal, so either can cross into
+// Money without a detour through float64:
+//
+//	m, err := money.FromDecimal(d, "EUR")
+//	d, err := decimal.NewFromString(m.DecimalString())
+type Decimal interface {
+	// String returns the number in decimal notation, optionally with an exponent
+	String() string
+}
+
+// scaledDecimal is met by decimal types exposing their coefficient and base ten
+// exponent, such as shopspring/decimal's Decimal, which FromDecimal reads
+// without printing and parsing them
+type scaledDecimal interface {
+	Coefficient() *big.Int
+	Exponent() int32
+}
+
+// maxDecimalExponent bounds the exponents FromDecimal accepts, far beyond those
+// of amounts fitting in int64 minor units, so huge ones fail before big.Rat
+// expands them
+const maxDecimalExponent = MaxParseLength
+
+// FromDecimal returns the Money exactly worth d in the currency, failing rather
+// than rounding when d has more fraction digits than the currency sub unit.
+// Types without Coefficient and Exponent methods are read from their String,
+// which must be a plain decimal, optionally with an exponent, of MaxParseLength
+// bytes at most.
+func FromDecimal(d Decimal, code string) (Money, error) {
+	code = strings.ToUpper(code)
+	c, ok := currencies[code]
+
+	if !ok {
+		return Money{}, fmt.Errorf("%w %q", ErrUnknownCurrency, code)
+	}
+
+	s, r, err := decimalValue(d, code)
+
+	if err != nil {
+		return Money{}, err
+	}
+
+	r.Mul(r, new(big.Rat).SetInt64(c.units()))
+
+	if !r.IsInt() {
+		return Money{}, fmt.Errorf("money: %s has more than %d fraction digits for %s", s, c.exponent(), code)
+	}
+
+	if !r.Num().IsInt64() {
+		return Money{}, fmt.Errorf("%w: %s %s", ErrOverflow, s, code)
+	}
+
+	return Money{r.Num().Int64(), code}, nil
+}
+
+// decimalValue returns d as text and as a big.Rat, rejecting exponents beyond
+// maxDecimalExponent before they are expanded
+func decimalValue(d Decimal, code string) (string, *big.Rat, error) {
+	if scaled, ok := d.(scaledDecimal); ok {
+		coefficient, exp := scaled.Coefficient(), int64(scaled.Exponent())
+		s := fmt.Sprintf("%se%d", coefficient, exp)
+		r := new(big.Rat).SetInt(coefficient)
+
+		if r.Sign() == 0 {
+			return s, r, nil
+		}
+
+		if err := checkDecimalExponent(s, exp, code); err != nil {
+			return s, nil, err
+		}
+
+		scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(absUnits(exp))), nil))
+
+		if exp < 0 {
+			return s, r.Quo(r, scale), nil
+		}
+
+		return s, r.Mul(r, scale), nil
+	}
+
+	s := d.String()
+
+	if len(s) > MaxParseLength {
+		return s, nil, fmt.Errorf("money: decimal %q... is longer than %d bytes", s[:16], MaxParseLength)
+	}
+
+	i := strings.IndexAny(s, "eE")
+
+	if i < 0 {
+		i = len(s)
+	}
+
+	r, ok := new(big.Rat).SetString(s[:i])
+
+	if !ok || strings.Contains(s, "/") {
+		return s, nil, fmt.Errorf("money: invalid decimal %q", s)
+	}
+
+	if i == len(s) {
+		return s, r, nil
+	}
+
+	// out of range exponents parse as the int64 bound of their sign
+	exp, err := strconv.ParseInt(s[i+1:], 10, 64)
+
+	if err != nil && !errors.Is(err, strconv.ErrRange) {
+		return s, nil, fmt.Errorf("money: invalid decimal %q", s)
+	}
+
+	if r.Sign() == 0 {
+		return s, r, nil
+	}
+
+	if err := checkDecimalExponent(s, exp, code); err != nil {
+		return s, nil, err
+	}
+
+	if r, ok = new(big.Rat).SetString(s); !ok {
+		return s, nil, fmt.Errorf("money: invalid decimal %q", s)
+	}
+
+	return s, r, nil
+}
+
+// checkDecimalExponent rejects the exponent of the non zero decimal s when it
+// is beyond maxDecimalExponent
+func checkDecimalExponent(s string, exp int64, code string) error {
+	switch {
+	case exp > maxDecimalExponent:
+		return fmt.Errorf("%w: %s %s", ErrOverflow, s, code)
+	case exp < -maxDecimalExponent:
+		return fmt.Errorf("money: %s has more than %d fraction digits for %s", s, currencies[code].exponent(), code)
+	}
+
+	return nil
+}
+
+// DecimalString returns m in plain decimal notation with every digit of the
+// currency sub unit, e.g. "-1234.50", for decimal types to parse
+func (m Money) DecimalString() string {
+	c := currencies[m.currency]
+	units, abs := uint64(c.units()), absUnits(m.amount)
+	var dst []byte
+
+	if m.amount < 0 {
+		dst = append(dst, '-')
+	}
+
+	dst = appendGrouped(dst, abs/units, "")
+
+	if digits := c.exponent(); digits > 0 {
+		dst = append(dst, '.')
+		dst = appendFraction(dst, abs%units*pow10(digits)/units, digits)
+	}
+
+	return string(dst)
+}
+
+// Scale returns the fraction digits of the currency, as BigDecimal.scale does for
+// the amount, so m equals Unscaled() * 10^-Scale()
+func (m Money) Scale() int {
+	return currencies[m.currency].exponent()
+}
+
+// Unscaled returns the amount counted in 10^-Scale() units of the currency, as
+// BigDecimal.unscaledValue does. It is the minor units for decimal sub units,
+// and twice them for the fifths of MGA and MRO.
+func (m Money) Unscaled() *big.Int {
+	c := currencies[m.currency]
+	unscaled := new(big.Int).Mul(big.NewInt(m.amount), new(big.Int).SetUint64(pow10(c.exponent())))
+
+	return unscaled.Quo(unscaled, big.NewInt(c.units()))
+}
+
+// PlainString returns m as BigDecimal.toPlainString does with Scale digits, the
+// same as DecimalString
+func (m Money) PlainString() string {
+	return m.DecimalString()
+}
+
+// roundHalfUp rounds a non negative decimal string to the given fraction digits,
+// rounding ties away from zero
+func roundHalfUp(value string, digits int) string {
+	return roundDecimal(value, digits, false)
+}
+
+// roundHalfEven rounds a non negative decimal string to the given fraction digits,
+// rounding ties to the nearest even digit
+func roundHalfEven(value string, digits int) string {
+	return roundDecimal(value, digits, true)
+}
+
+func roundDecimal(value string, digits int, halfEven bool) string {
+	integer, fractional := splitDecimal(value)
+
+	for len(fractional) <= digits {
+		fractional += "0"
+	}
+
+	number := []byte(integer + fractional[:digits])
+	next, rest := fractional[digits], strings.Trim(fractional[digits+1:], "0")
+
+	carry := next > '5' || next == '5' && (!halfEven || rest != "" || (number[len(number)-1]-'0')%2 == 1)
+
+	for i := len(number) - 1; carry && i >= 0; i-- {
+		if number[i] == '9' {
+			number[i] = '0'
+			continue
+		}
+
+		number[i]++
+		carry = false
+	}
+
+	if carry {
+		number = append([]byte{'1'}, number...)
+	}
+
+	if digits == 0 {
+		return string(number)
+	}
+
+	return fmt.Sprintf("%s.%s", number[:len(number)-digits], number[len(number)-digits:])
+}
+
+// splitDecimal splits a decimal string into its integer and fractional digits
+func splitDecimal(value string) (integer, fractional string) {
+	if i := strings.IndexByte(value, '.'); i >= 0 {
+		return value[:i], value[i+1:]
+	}
+
+	return value, ""
+}
@@ -0,0 +1,200 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestRoundHalfUp(t *testing.T) {
+	values := map[string]string{
+		"0":        "0.00",
+		"1.5":      "1.50",
+		"1.004":    "1.00",
+		"1.005":    "1.01",
+		"1.015":    "1.02",
+		"9.995":    "10.00",
+		"99.999":   "100.00",
+		"12.34567": "12.35",
+	}
+
+	for value, expected := range values {
+		if v := roundHalfUp(value, 2); v != expected {
+			t.Errorf("Expected %s to be %s but got %s", value, expected, v)
+		}
+	}
+
+	if v := roundHalfUp("2.5", 0); v != "3" {
+		t.Errorf("Expected 2.5 to be 3 but got %s", v)
+	}
+}
+
+func TestRoundHalfEven(t *testing.T) {
+	values := map[string]string{
+		"1.005":   "1.00",
+		"1.015":   "1.02",
+		"1.0051":  "1.01",
+		"1.00500": "1.00",
+		"9.995":   "10.00",
+		"0.125":   "0.12",
+	}
+
+	for value, expected := range values {
+		if v := roundHalfEven(value, 2); v != expected {
+			t.Errorf("Expected %s to be %s but got %s", value, expected, v)
+		}
+	}
+
+	if v := roundHalfEven("2.5", 0); v != "2" {
+		t.Errorf("Expected 2.5 to be 2 but got %s", v)
+	}
+}
+
+func TestSplitDecimal(t *testing.T) {
+	if i, f := splitDecimal("12.345"); i != "12" || f != "345" {
+		t.Errorf("Expected 12 and 345 but got %s and %s", i, f)
+	}
+
+	if i, f := splitDecimal("12"); i != "12" || f != "" {
+		t.Errorf("Expected 12 and no fraction but got %s and %s", i, f)
+	}
+}
+
+// stringDecimal stands in for a decimal type such as shopspring/decimal's Decimal
+type stringDecimal string
+
+func (d stringDecimal) String() string {
+	return string(d)
+}
+
+// coefficientDecimal stands in for a decimal type exposing its coefficient and
+// exponent, such as shopspring/decimal's Decimal
+type coefficientDecimal struct {
+	coefficient int64
+	exponent    int32
+}
+
+func (d coefficientDecimal) String() string {
+	return "unused"
+}
+
+func (d coefficientDecimal) Coefficient() *big.Int {
+	return big.NewInt(d.coefficient)
+}
+
+func (d coefficientDecimal) Exponent() int32 {
+	return d.exponent
+}
+
+func TestFromDecimal(t *testing.T) {
+	values := []struct {
+		decimal  string
+		code     string
+		expected Money
+	}{
+		{"1234.5", "eur", Money{123450, "EUR"}},
+		{"1.5E+2", "USD", Money{15000, "USD"}},
+		{"-0.01", "USD", Money{-1, "USD"}},
+		{"1.2300", "USD", Money{123, "USD"}},
+		{"100", "JPY", Money{100, "JPY"}},
+		{"0.001", "BHD", Money{1, "BHD"}},
+	}
+
+	for _, v := range values {
+		m, err := FromDecimal(stringDecimal(v.decimal), v.code)
+
+		if err != nil || m != v.expected {
+			t.Errorf("Expected %s to be %s but got %s (%v)", v.decimal, v.expected, m, err)
+		}
+
+		if s := m.DecimalString(); s == "" {
+			t.Errorf("Expected a decimal string for %s", m)
+		} else if back, err := FromDecimal(stringDecimal(s), m.currency); err != nil || back != m {
+			t.Errorf("Expected %s to round trip but got %s (%v)", s, back, err)
+		}
+	}
+}
+
+func TestFromDecimalWhenInvalid(t *testing.T) {
+	for _, s := range []string{"1.001", "NaN", "Infinity", "1/3", "", "1,5"} {
+		if _, err := FromDecimal(stringDecimal(s), "USD"); err == nil {
+			t.Errorf("Expected %q to be rejected", s)
+		}
+	}
+
+	for _, d := range []Decimal{
+		stringDecimal("1e30"),
+		stringDecimal("1e999999999999"),
+		stringDecimal("1e99999999999999999999999"),
+		coefficientDecimal{1, math.MaxInt32},
+	} {
+		if _, err := FromDecimal(d, "USD"); !errors.Is(err, ErrOverflow) {
+			t.Errorf("Expected %v to fail with ErrOverflow but got %v", d, err)
+		}
+	}
+
+	for _, d := range []Decimal{
+		stringDecimal("1e-999999999999"),
+		stringDecimal("1" + strings.Repeat("0", MaxParseLength)),
+		stringDecimal("1e"),
+		stringDecimal("1e+x"),
+		coefficientDecimal{1, math.MinInt32},
+	} {
+		if _, err := FromDecimal(d, "USD"); err == nil {
+			t.Errorf("Expected %v to be rejected", d)
+		}
+	}
+
+	for _, d := range []Decimal{stringDecimal("0e999999999999"), coefficientDecimal{0, math.MaxInt32}} {
+		if m, err := FromDecimal(d, "USD"); err != nil || !m.IsZero() {
+			t.Errorf("Expected %v to be zero but got %s (%v)", d, m, err)
+		}
+	}
+
+	if m, err := FromDecimal(coefficientDecimal{-12345, -3}, "BHD"); err != nil || m != (Money{-12345, "BHD"}) {
+		t.Errorf("Expected -12.345 BHD but got %s (%v)", m, err)
+	}
+
+	if _, err := FromDecimal(stringDecimal("1"), "XYZ"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+}
+
+func TestMoneyDecimalString(t *testing.T) {
+	values := map[Money]string{
+		{-123450, "USD"}:              "-1234.50",
+		{5, "USD"}:                    "0.05",
+		{100, "JPY"}:                  "100",
+		{1, "BHD"}:                    "0.001",
+		{-9223372036854775808, "USD"}: "-92233720368547758.08",
+	}
+
+	for m, expected := range values {
+		if s := m.DecimalString(); s != expected {
+			t.Errorf("Expected %s but got %s", expected, s)
+		}
+	}
+}
+
+func TestMoneyScale(t *testing.T) {
+	values := []struct {
+		m        Money
+		scale    int
+		unscaled string
+		plain    string
+	}{
+		{Money{-123450, "USD"}, 2, "-123450", "-1234.50"},
+		{Money{1234, "BHD"}, 3, "1234", "1.234"},
+		{Money{100, "JPY"}, 0, "100", "100"},
+		{Money{7, "MGA"}, 1, "14", "1.4"},
+		{Money{math.MinInt64, "MGA"}, 1, "-18446744073709551616", "-1844674407370955161.6"},
+	}
+
+	for _, v := range values {
+		if s, u, p := v.m.Scale(), v.m.Unscaled().String(), v.m.PlainString(); s != v.scale || u != v.unscaled || p != v.plain {
+			t.Errorf("Expected %d %s %s for %s but got %d %s %s", v.scale, v.unscaled, v.plain, v.m, s, u, p)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package money
+
+import (
+	"fmt"
+)
+
+// LabeledMoney is an amount with a label, such as a line of an invoice or of a
+// statement
+type LabeledMoney struct {
+	Label  string
+	Amount Money
+}
+
+// LineChange is the kind of a LineDiff
+type LineChange int
+
+const (
+	// LineAdded is a line only in the second list
+	LineAdded LineChange = iota
+
+	// LineRemoved is a line only in the first list
+	LineRemoved
+
+	// LineChanged is a line in both lists with different amounts
+	LineChanged
+)
+
+// LineDiff is a line added, removed or changed between two lists. Delta is After
+// less Before, taking the missing side as zero.
+type LineDiff struct {
+	Label  string
+	Change LineChange
+	Before Money
+	After  Money
+	Delta  Money
+}
+
+// String returns the diff as a line of a report, e.g. "~ Shipping: $5.00 -> $6.50 (+$1.50)"
+func (d LineDiff) String() string {
+	switch d.Change {
+	case LineAdded:
+		return fmt.Sprintf("+ %s: %s", d.Label, d.After)
+	case LineRemoved:
+		return fmt.Sprintf("- %s: %s", d.Label, d.Before)
+	}
+
+	sign := ""
+
+	if d.Delta.IsPositive() {
+		sign = "+"
+	}
+
+	return fmt.Sprintf("~ %s: %s -> %s (%s%s)", d.Label, d.Before, d.After, sign, d.Delta)
+}
+
+// DiffLines compares two lists of labeled amounts, matching the lines of a with
+// those of b by label, the nth line of a label with the nth one of b. It returns
+// the removed and changed lines in the order of a, followed by the added lines
+// in the order of b, leaving out the unchanged ones. It fails when matched lines
+// are in different currencies or a delta overflows.
+func DiffLines(a, b []LabeledMoney) ([]LineDiff, error) {
+	pending := map[string][]int{}
+
+	for i, line := range b {
+		pending[line.Label] = append(pending[line.Label], i)
+	}
+
+	matched := make([]bool, len(b))
+	var diffs []LineDiff
+
+	for _, line := range a {
+		indexes := pending[line.Label]
+
+		if len(indexes) == 0 {
+			delta, err := Money{0, line.Amount.currency}.Subtract(line.Amount)
+
+			if err != nil {
+				return nil, fmt.Errorf("money: line %q: %w", line.Label, err)
+			}
+
+			diffs = append(diffs, LineDiff{line.Label, LineRemoved, line.Amount, Money{}, delta})
+			continue
+		}
+
+		pending[line.Label] = indexes[1:]
+		matched[indexes[0]] = true
+		after := b[indexes[0]].Amount
+
+		if after == line.Amount {
+			continue
+		}
+
+		delta, err := after.Subtract(line.Amount)
+
+		if err != nil {
+			return nil, fmt.Errorf("money: line %q: %w", line.Label, err)
+		}
+
+		diffs = append(diffs, LineDiff{line.Label, LineChanged, line.Amount, after, delta})
+	}
+
+	for i, line := range b {
+		if !matched[i] {
+			diffs = append(diffs, LineDiff{line.Label, LineAdded, Money{}, line.Amount, line.Amount})
+		}
+	}
+
+	return diffs, nil
+}
@@ -0,0 +1,61 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestDiffLines(t *testing.T) {
+	a := []LabeledMoney{
+		{"Widget", Money{1000, "USD"}},
+		{"Shipping", Money{500, "USD"}},
+		{"Fee", Money{100, "USD"}},
+		{"Fee", Money{200, "USD"}},
+		{"Discount", Money{-150, "USD"}},
+	}
+
+	b := []LabeledMoney{
+		{"Widget", Money{1000, "USD"}},
+		{"Fee", Money{100, "USD"}},
+		{"Shipping", Money{650, "USD"}},
+		{"Fee", Money{150, "USD"}},
+		{"Tax", Money{90, "USD"}},
+	}
+
+	expected := []LineDiff{
+		{"Shipping", LineChanged, Money{500, "USD"}, Money{650, "USD"}, Money{150, "USD"}},
+		{"Fee", LineChanged, Money{200, "USD"}, Money{150, "USD"}, Money{-50, "USD"}},
+		{"Discount", LineRemoved, Money{-150, "USD"}, Money{}, Money{150, "USD"}},
+		{"Tax", LineAdded, Money{}, Money{90, "USD"}, Money{90, "USD"}},
+	}
+
+	diffs, err := DiffLines(a, b)
+
+	if err != nil || !reflect.DeepEqual(diffs, expected) {
+		t.Errorf("Expected %v but got %v %v", expected, diffs, err)
+	}
+
+	lines := []string{"~ Shipping: $5.00 -> $6.50 (+$1.50)", "~ Fee: $2.00 -> $1.50 (-$0.50)", "- Discount: -$1.50", "+ Tax: $0.90"}
+
+	for i, d := range diffs {
+		if s := d.String(); i < len(lines) && s != lines[i] {
+			t.Errorf("Expected %s but got %s", lines[i], s)
+		}
+	}
+
+	if diffs, err := DiffLines(a, a); err != nil || len(diffs) != 0 {
+		t.Errorf("Expected no diffs but got %v %v", diffs, err)
+	}
+}
+
+func TestDiffLinesWhenInvalid(t *testing.T) {
+	if _, err := DiffLines([]LabeledMoney{{"Fee", Money{100, "USD"}}}, []LabeledMoney{{"Fee", Money{100, "EUR"}}}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+
+	if _, err := DiffLines([]LabeledMoney{{"Fee", Money{math.MinInt64, "USD"}}}, nil); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow but got %v", err)
+	}
+}
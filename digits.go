@@ -0,0 +1,74 @@
+package money
+
+// digitPairs holds the two digit decimal representations of 0 to 99
+const digitPairs = "" +
+	"00010203040506070809" +
+	"10111213141516171819" +
+	"20212223242526272829" +
+	"30313233343536373839" +
+	"40414243444546474849" +
+	"50515253545556575859" +
+	"60616263646566676869" +
+	"70717273747576777879" +
+	"80818283848586878889" +
+	"90919293949596979899"
+
+// appendGrouped appends the decimal digits of u to dst, two at a time, with
+// separator between groups of three digits; an empty separator groups nothing
+func appendGrouped(dst []byte, u uint64, separator string) []byte {
+	var buf [20]byte
+
+	i := len(buf)
+
+	for u >= 100 {
+		q := u / 100
+		j := (u - q*100) * 2
+		i -= 2
+		buf[i], buf[i+1] = digitPairs[j], digitPairs[j+1]
+		u = q
+	}
+
+	if u >= 10 {
+		i -= 2
+		buf[i], buf[i+1] = digitPairs[u*2], digitPairs[u*2+1]
+	} else {
+		i--
+		buf[i] = byte('0' + u)
+	}
+
+	return appendGroupedDigits(dst, buf[i:], separator)
+}
+
+// appendFraction appends f zero padded to n digits
+func appendFraction(dst []byte, f uint64, n int) []byte {
+	var buf [20]byte
+
+	for i := n - 1; i >= 0; i-- {
+		buf[i] = byte('0' + f%10)
+		f /= 10
+	}
+
+	return append(dst, buf[:n]...)
+}
+
+// appendGroupedDigits appends the digits with separator between groups of three
+func appendGroupedDigits(dst, digits []byte, separator string) []byte {
+	if separator == "" || len(digits) <= 3 {
+		return append(dst, digits...)
+	}
+
+	first := len(digits) % 3
+
+	if first == 0 {
+		first = 3
+	}
+
+	dst = append(dst, digits[:first]...)
+
+	for i := first; i < len(digits); i += 3 {
+		dst = append(dst, separator...)
+		dst = append(dst, digits[i:i+3]...)
+	}
+
+	return dst
+}
@@ -0,0 +1,75 @@
+package money
+
+import (
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestAppendGrouped(t *testing.T) {
+	values := []uint64{0, 7, 10, 99, 100, 999, 1000, 12345, 123456, 1234567, 10000000, math.MaxUint64}
+
+	for _, u := range values {
+		expected := separateThousands(strconv.FormatUint(u, 10), ",")
+
+		if s := string(appendGrouped(nil, u, ",")); s != expected {
+			t.Errorf("Expected %d to be %s but got %s", u, expected, s)
+		}
+
+		if s := string(appendGrouped(nil, u, "")); s != strconv.FormatUint(u, 10) {
+			t.Errorf("Expected %d without separator to be %d but got %s", u, u, s)
+		}
+	}
+
+	if s := string(appendGrouped([]byte("$"), 1234567, " ")); s != "$1 234 567" {
+		t.Errorf("Expected a multi byte separator but got %q", s)
+	}
+}
+
+func TestAppendFraction(t *testing.T) {
+	values := []struct {
+		f        uint64
+		n        int
+		expected string
+	}{
+		{5, 2, "05"},
+		{50, 2, "50"},
+		{7, 3, "007"},
+		{0, 1, "0"},
+		{0, 0, ""},
+	}
+
+	for _, v := range values {
+		if s := string(appendFraction(nil, v.f, v.n)); s != v.expected {
+			t.Errorf("Expected %d over %d digits to be %s but got %s", v.f, v.n, v.expected, s)
+		}
+	}
+}
+
+func BenchmarkAppendGrouped(b *testing.B) {
+	buf := make([]byte, 0, 32)
+
+	for i := 0; i < b.N; i++ {
+		buf = appendGrouped(buf[:0], uint64(i)*7919, ",")
+	}
+}
+
+func BenchmarkStrconvGrouped(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		separateThousands(strconv.FormatUint(uint64(i)*7919, 10), ",")
+	}
+}
+
+func BenchmarkBatchFormat(b *testing.B) {
+	amounts := make([]int64, 1000)
+
+	for i := range amounts {
+		amounts[i] = int64(i) * 104729
+	}
+
+	batch, _ := NewBatchFromMinorUnits(amounts, "USD")
+
+	for i := 0; i < b.N; i++ {
+		batch.Format()
+	}
+}
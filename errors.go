@@ -0,0 +1,94 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+var (
+	// ErrUnknownCurrency reports a currency code missing from the registry
+	ErrUnknownCurrency = errors.New("money: unknown currency")
+
+	// ErrCurrencyMismatch reports an operation combining amounts in different currencies
+	ErrCurrencyMismatch = errors.New("money: currency mismatch")
+
+	// ErrOverflow reports a result that does not fit in the amount representation
+	ErrOverflow = errors.New("money: overflow")
+
+	// ErrNoRate reports a missing exchange rate between two currencies
+	ErrNoRate = errors.New("money: no exchange rate")
+
+	// ErrRateDeviation reports an exchange rate too far from the reference rate
+	ErrRateDeviation = errors.New("money: exchange rate deviates from reference")
+
+	// ErrProvidersDown reports a Failover whose providers all failed or are
+	// cooling down after failures
+	ErrProvidersDown = errors.New("money: rate providers down")
+
+	// ErrBadAttestation reports a RateAttestation failing verification
+	ErrBadAttestation = errors.New("money: invalid rate attestation")
+
+	// ErrInsufficientBalance reports a redemption exceeding the balance of a Credit
+	ErrInsufficientBalance = errors.New("money: insufficient balance")
+
+	// ErrExpired reports a redemption of an expired Credit
+	ErrExpired = errors.New("money: credit expired")
+
+	// ErrNoPrice reports an id missing from a PriceTable
+	ErrNoPrice = errors.New("money: no price")
+
+	// ErrFrozen reports a registration attempted after Freeze
+	ErrFrozen = errors.New("money: dataset is frozen")
+
+	// ErrInexact reports a float64 amount that does not convert to Money exactly
+	ErrInexact = errors.New("money: inexact amount")
+
+	// ErrInternal reports a panic recovered in panic free mode
+	ErrInternal = errors.New("money: internal error")
+)
+
+// ParseError describes a failure to parse Input, located at byte offset Pos.
+// Suggestion, when not empty, proposes a likely intended input.
+type ParseError struct {
+	Input      string
+	Pos        int
+	Msg        string
+	Suggestion string
+	Err        error
+}
+
+// maxErrorInput bounds the runes of input quoted by ParseError.Error
+const maxErrorInput = 64
+
+// Error returns a description of the failure including its position. Long input
+// is shortened.
+func (e *ParseError) Error() string {
+	input := fmt.Sprintf("%.*q", maxErrorInput, e.Input)
+
+	if len(e.Input) > maxErrorInput*utf8.UTFMax || utf8.RuneCountInString(e.Input) > maxErrorInput {
+		input += "..."
+	}
+
+	msg := fmt.Sprintf("money: cannot parse %s at offset %d: %s", input, e.Pos, e.Msg)
+
+	if e.Suggestion != "" {
+		msg = fmt.Sprintf("%s (did you mean %s?)", msg, e.Suggestion)
+	}
+
+	return msg
+}
+
+// RunePos returns the position of the failure counted in runes rather than bytes
+func (e *ParseError) RunePos() int {
+	if e.Pos > len(e.Input) {
+		return utf8.RuneCountInString(e.Input)
+	}
+
+	return utf8.RuneCountInString(e.Input[:e.Pos])
+}
+
+// Unwrap returns the underlying cause, such as ErrUnknownCurrency
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,46 @@
+package money
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseError(t *testing.T) {
+	err := &ParseError{Input: "EUR/XYZ", Pos: 4, Msg: "unknown currency XYZ", Err: ErrUnknownCurrency}
+	expected := `money: cannot parse "EUR/XYZ" at offset 4: unknown currency XYZ`
+
+	if err.Error() != expected {
+		t.Errorf("Expected %s but got %s", expected, err)
+	}
+
+	if !errors.Is(err, ErrUnknownCurrency) {
+		t.Error("Expected error to unwrap to ErrUnknownCurrency")
+	}
+}
+
+func TestParseErrorWithSuggestion(t *testing.T) {
+	err := &ParseError{Input: "1.234.56", Pos: 5, Msg: "unexpected decimal mark", Suggestion: "1,234.56"}
+	expected := `money: cannot parse "1.234.56" at offset 5: unexpected decimal mark (did you mean 1,234.56?)`
+
+	if err.Error() != expected {
+		t.Errorf("Expected %s but got %s", expected, err)
+	}
+}
+
+func TestParseErrorRunePos(t *testing.T) {
+	err := &ParseError{Input: "€1,2x", Pos: 6}
+
+	if pos := err.RunePos(); pos != 4 {
+		t.Errorf("Expected rune position 4 but got %d", pos)
+	}
+}
+
+func TestParseErrorWhenLong(t *testing.T) {
+	err := &ParseError{Input: strings.Repeat("€", 100), Pos: 0, Msg: "unexpected character"}
+	expected := `money: cannot parse "` + strings.Repeat("€", 64) + `"... at offset 0: unexpected character`
+
+	if err.Error() != expected {
+		t.Errorf("Expected %s but got %s", expected, err)
+	}
+}
@@ -0,0 +1,117 @@
+package money_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/joiggama/money"
+)
+
+func ExampleFormat() {
+	fmt.Println(money.Format(1234.5))
+	fmt.Println(money.Format(1234.5, money.Options{"currency": "EUR", "with_currency": true}))
+	fmt.Println(money.Format(1234.5, money.Options{"with_cents": false, "with_thousands_separator": false}))
+	// Output:
+	// $1,234.50
+	// €1.234,50 EUR
+	// $1234
+}
+
+func ExampleMoney_Add() {
+	price, _ := money.FromMinorUnits(1999, "USD")
+	shipping, _ := money.FromFloat(4.5, "USD")
+
+	total, _ := price.Add(shipping)
+	fmt.Println(total)
+
+	double, _ := total.Multiply(2)
+	fmt.Println(double, double.MinorUnits())
+
+	fee, _ := money.FromMinorUnits(100, "EUR")
+	_, err := total.Add(fee)
+	fmt.Println(err)
+	// Output:
+	// $24.49
+	// $48.98 4898
+	// money: currency mismatch: USD and EUR
+}
+
+func ExampleMoney_Allocate() {
+	m, _ := money.FromMinorUnits(1000, "USD")
+
+	parts, _ := m.Allocate(70, 20, 10)
+	fmt.Println(parts)
+
+	parts, _ = m.Split(3)
+	fmt.Println(parts)
+	// Output:
+	// [$7.00 $2.00 $1.00]
+	// [$3.34 $3.33 $3.33]
+}
+
+func ExampleParse() {
+	m, _ := money.Parse("€1.234,56")
+	fmt.Println(m.MinorUnits(), m.Currency())
+
+	m, _ = money.Parse("1,234.56 USD")
+	fmt.Println(m)
+
+	_, err := money.Parse("€1,234.56")
+	fmt.Println(err)
+	// Output:
+	// 123456 EUR
+	// $1,234.56
+	// money: cannot parse "€1,234.56" at offset 8: thousands separator after decimal mark (did you mean €1.234,56?)
+}
+
+func ExampleExchange_ConvertMoney() {
+	e, _ := money.NewExchange("EUR", nil)
+	e.SetPairRate(money.Pair{Base: "EUR", Quote: "USD"}, 1.08)
+
+	m, _ := money.FromMinorUnits(10000, "EUR")
+	converted, _ := e.ConvertMoney(m, "USD")
+	fmt.Println(converted)
+
+	back, _ := e.ConvertMoney(converted, "EUR")
+	fmt.Println(back)
+	// Output:
+	// $108.00
+	// €100,00
+}
+
+func ExampleMoney_MarshalJSON() {
+	price, _ := money.FromMinorUnits(1050, "USD")
+	data, _ := json.Marshal(price)
+	fmt.Println(string(data))
+
+	var m money.Money
+	json.Unmarshal(data, &m)
+	fmt.Println(m)
+	// Output:
+	// {"amount":1050,"currency":"USD"}
+	// $10.50
+}
+
+func ExampleNewTemplate() {
+	t := money.NewTemplate(money.Options{"currency": "EUR"})
+	total, _ := money.FromMinorUnits(123456, "EUR")
+	fee, _ := money.FromMinorUnits(999, "EUR")
+
+	fmt.Println(t.Format(total))
+	fmt.Println(string(t.AppendFormat([]byte("Total: "), fee)))
+	// Output:
+	// €1.234,56
+	// Total: €9,99
+}
+
+func ExampleFormatTotals() {
+	rent, _ := money.FromMinorUnits(7000, "USD")
+	meal, _ := money.FromMinorUnits(4500, "EUR")
+	tip, _ := money.FromMinorUnits(500, "USD")
+
+	totals, _ := money.TotalByCurrency(rent, meal, tip)
+
+	fmt.Println(money.FormatTotals(totals, ", "))
+	// Output:
+	// €45,00, $75.00
+}
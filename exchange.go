@@ -0,0 +1,277 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateProvider supplies exchange rates, e.g. from a live feed or a cached table
+type RateProvider interface {
+	// Rate returns the amount of to bought by one unit of from, failing with
+	// ErrNoRate when the provider does not know the pair
+	Rate(from, to string) (float64, error)
+}
+
+// RateProviderFunc adapts a function to the RateProvider interface
+type RateProviderFunc func(from, to string) (float64, error)
+
+// Rate calls f(from, to)
+func (f RateProviderFunc) Rate(from, to string) (float64, error) {
+	return f(from, to)
+}
+
+// Converter converts Money into another currency, as Exchange does
+type Converter interface {
+	ConvertMoney(m Money, to string) (Money, error)
+}
+
+// Exchange converts between registry currencies using rates set on it or, for
+// pairs it does not hold, rates from its provider. A missing pair is derived from
+// its inverse, or from the rates of both currencies against the base currency.
+type Exchange struct {
+	mu       sync.RWMutex
+	base     string
+	provider RateProvider
+	rates    map[Pair]float64
+}
+
+// NewExchange returns an Exchange deriving missing rates through the base
+// currency and falling back to provider, which may be nil
+func NewExchange(base string, provider RateProvider) (*Exchange, error) {
+	base = strings.ToUpper(base)
+
+	if _, ok := currencies[base]; !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownCurrency, base)
+	}
+
+	return &Exchange{base: base, provider: provider, rates: map[Pair]float64{}}, nil
+}
+
+// SetRate sets the amount of to bought by one unit of from.
+//
+// Deprecated: use SetPairRate, whose Pair cannot swap the currencies by mistake.
+func (e *Exchange) SetRate(from, to string, rate float64) error {
+	return e.SetPairRate(Pair{from, to}, rate)
+}
+
+// SetPairRate sets the amount of the quote currency bought by one unit of the
+// base currency of pair
+func (e *Exchange) SetPairRate(pair Pair, rate float64) error {
+	pair = Pair{strings.ToUpper(pair.Base), strings.ToUpper(pair.Quote)}
+
+	if err := pair.Validate(); err != nil {
+		return err
+	}
+
+	if !(rate > 0) || math.IsInf(rate, 0) {
+		return fmt.Errorf("money: invalid exchange rate %v for %s", rate, pair)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.rates[pair] = rate
+
+	return nil
+}
+
+// Rate returns the amount of to bought by one unit of from, as PairRate does.
+// It lets an Exchange serve as the RateProvider of another.
+//
+// Deprecated: use PairRate, whose Pair cannot swap the currencies by mistake.
+func (e *Exchange) Rate(from, to string) (float64, error) {
+	return e.PairRate(Pair{from, to})
+}
+
+// PairRate returns the amount of the quote currency bought by one unit of the
+// base currency of pair, failing with ErrNoRate when neither the pair, its
+// inverse nor a path through the base currency is known
+func (e *Exchange) PairRate(pair Pair) (rate float64, err error) {
+	defer guard(&err)
+
+	rate, _, err = e.resolve(Pair{strings.ToUpper(pair.Base), strings.ToUpper(pair.Quote)})
+
+	return rate, err
+}
+
+// resolve returns the rate of pair and the legs it was derived from
+func (e *Exchange) resolve(pair Pair) (float64, []RateLeg, error) {
+	if _, ok := currencies[pair.Base]; ok && pair.Base == pair.Quote {
+		return 1, []RateLeg{{pair, 1, RateIdentity}}, nil
+	}
+
+	if err := pair.Validate(); err != nil {
+		return 0, nil, err
+	}
+
+	if rate, source, err := e.rate(pair); !errors.Is(err, ErrNoRate) {
+		return rate, []RateLeg{{pair, rate, source}}, err
+	}
+
+	if pair.Base != e.base && pair.Quote != e.base {
+		in, inSource, err := e.rate(Pair{pair.Base, e.base})
+
+		if err == nil {
+			var out float64
+			var outSource RateSource
+
+			if out, outSource, err = e.rate(Pair{e.base, pair.Quote}); err == nil {
+				return in * out, []RateLeg{{Pair{pair.Base, e.base}, in, inSource}, {Pair{e.base, pair.Quote}, out, outSource}}, nil
+			}
+		}
+
+		if !errors.Is(err, ErrNoRate) {
+			return 0, nil, err
+		}
+	}
+
+	return 0, nil, fmt.Errorf("%w for %s", ErrNoRate, pair)
+}
+
+// Convert returns val in from converted to to, rounded to the minor unit of to
+func (e *Exchange) Convert(val float64, from, to string) (float64, error) {
+	m, err := FromFloat(val, from)
+
+	if err != nil {
+		return 0, err
+	}
+
+	converted, err := e.ConvertMoney(m, to)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return converted.Float(), nil
+}
+
+// ConvertMoney returns m converted to to, rounded half away from zero to the
+// minor unit of to
+func (e *Exchange) ConvertMoney(m Money, to string) (converted Money, err error) {
+	to = strings.ToUpper(to)
+	defer func() { observeConversion(Pair{m.currency, to}, err) }()
+
+	rate, err := e.PairRate(Pair{m.currency, to})
+
+	if err != nil {
+		return Money{}, err
+	}
+
+	return convertUnits(m, to, decimalRat(rate))
+}
+
+// ConvertWithTolerance is like ConvertMoney, failing with ErrRateDeviation when
+// the current rate deviates from reference, the rate the caller quoted, e.g. at
+// checkout, by more than maxBps basis points of reference
+func (e *Exchange) ConvertWithTolerance(m Money, to string, reference, maxBps float64) (converted Money, err error) {
+	to = strings.ToUpper(to)
+	defer func() { observeConversion(Pair{m.currency, to}, err) }()
+
+	if !(reference > 0) || math.IsInf(reference, 0) {
+		return Money{}, fmt.Errorf("money: invalid reference rate %v", reference)
+	}
+
+	if !(maxBps >= 0) || math.IsInf(maxBps, 0) {
+		return Money{}, fmt.Errorf("money: invalid tolerance %v bps", maxBps)
+	}
+
+	rate, err := e.PairRate(Pair{m.currency, to})
+
+	if err != nil {
+		return Money{}, err
+	}
+
+	// |rate - reference| / reference compared to maxBps / 10000, exactly
+	current, quoted := decimalRat(rate), decimalRat(reference)
+	deviation := new(big.Rat).Sub(current, quoted)
+	deviation.Abs(deviation).Quo(deviation, quoted).Mul(deviation, big.NewRat(10000, 1))
+
+	if deviation.Cmp(decimalRat(maxBps)) > 0 {
+		bps, _ := deviation.Float64()
+		return Money{}, fmt.Errorf("%w: %s rate %v is %.1f bps from %v", ErrRateDeviation, Pair{m.currency, to}, rate, bps, reference)
+	}
+
+	return convertUnits(m, to, current)
+}
+
+// FormatConverted converts val in from to to and formats it as Money.Format does,
+// so the result shows the sub unit precision of to
+func (e *Exchange) FormatConverted(val float64, from, to string, opts ...Options) (string, error) {
+	noteDeprecated(opts...)
+
+	m, err := FromFloat(val, from)
+
+	if err != nil {
+		return "", err
+	}
+
+	converted, err := e.ConvertMoney(m, to)
+
+	if err != nil {
+		return "", err
+	}
+
+	return converted.Format(opts...), nil
+}
+
+// rate looks up pair, then its inverse, in the table and then in the provider
+func (e *Exchange) rate(pair Pair) (float64, RateSource, error) {
+	e.mu.RLock()
+	rate, ok := e.rates[pair]
+	source := RateSet
+
+	if !ok {
+		if inverse, found := e.rates[pair.Inverse()]; found {
+			rate, ok, source = 1/inverse, true, RateInverse
+		}
+	}
+
+	provider := e.provider
+	e.mu.RUnlock()
+
+	if ok {
+		return rate, source, nil
+	}
+
+	if provider == nil {
+		return 0, 0, fmt.Errorf("%w for %s", ErrNoRate, pair)
+	}
+
+	start := time.Now()
+	rate, err := provider.Rate(pair.Base, pair.Quote)
+
+	if mt := currentMetrics(); mt != nil {
+		mt.RateFetched(pair, time.Since(start), err)
+	}
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if !(rate > 0) || math.IsInf(rate, 0) {
+		return 0, 0, fmt.Errorf("money: invalid exchange rate %v for %s", rate, pair)
+	}
+
+	return rate, RateProvided, nil
+}
+
+// convertUnits returns m times rate in the currency to, rounded half away from
+// zero to its minor unit
+func convertUnits(m Money, to string, rate *big.Rat) (Money, error) {
+	r := new(big.Rat).SetInt64(m.amount)
+	r.Mul(r, rate)
+	r.Mul(r, big.NewRat(currencies[to].units(), currencies[m.currency].units()))
+
+	units, ok := roundRat(r)
+
+	if !ok {
+		return Money{}, fmt.Errorf("%w: %s in %s", ErrOverflow, m, to)
+	}
+
+	return Money{units, to}, nil
+}
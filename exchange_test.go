@@ -0,0 +1,198 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func sampleExchange(t *testing.T) *Exchange {
+	e, err := NewExchange("usd", nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, r := range []struct {
+		from, to string
+		rate     float64
+	}{
+		{"USD", "EUR", 0.9},
+		{"USD", "JPY", 150},
+		{"BHD", "USD", 2.65},
+	} {
+		if err := e.SetRate(r.from, r.to, r.rate); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return e
+}
+
+func TestExchangeRate(t *testing.T) {
+	e := sampleExchange(t)
+
+	values := []struct {
+		from, to string
+		expected float64
+	}{
+		{"usd", "eur", 0.9},
+		{"EUR", "USD", 1 / 0.9},
+		{"EUR", "JPY", 150 / 0.9},
+		{"BHD", "EUR", 2.65 * 0.9},
+		{"EUR", "EUR", 1},
+	}
+
+	for _, v := range values {
+		rate, err := e.Rate(v.from, v.to)
+
+		if err != nil || math.Abs(rate-v.expected) > 1e-9 {
+			t.Errorf("Expected %s/%s to be %v but got %v %v", v.from, v.to, v.expected, rate, err)
+		}
+	}
+
+	if _, err := e.Rate("EUR", "GBP"); !errors.Is(err, ErrNoRate) {
+		t.Errorf("Expected ErrNoRate but got %v", err)
+	}
+
+	if _, err := e.Rate("EUR", "XYZ"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+}
+
+func TestExchangePairRate(t *testing.T) {
+	e := sampleExchange(t)
+	pair, _ := ParsePair("GBP/USD")
+
+	if err := e.SetPairRate(pair, 1.25); err != nil {
+		t.Fatal(err)
+	}
+
+	if rate, err := e.PairRate(pair.Inverse()); err != nil || rate != 0.8 {
+		t.Errorf("Expected USD/GBP to be 0.8 but got %v %v", rate, err)
+	}
+
+	if rate, err := e.PairRate(Pair{"gbp", "usd"}); err != nil || rate != 1.25 {
+		t.Errorf("Expected GBP/USD to be 1.25 but got %v %v", rate, err)
+	}
+
+	if err := e.SetPairRate(Pair{"GBP", "XYZ"}, 1); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+}
+
+func TestExchangeSetRateWhenInvalid(t *testing.T) {
+	e := sampleExchange(t)
+
+	for _, rate := range []float64{0, -1, math.NaN(), math.Inf(1)} {
+		if err := e.SetRate("USD", "GBP", rate); err == nil {
+			t.Errorf("Expected rate %v to be rejected", rate)
+		}
+	}
+
+	if _, err := NewExchange("XYZ", nil); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+}
+
+func TestExchangeProvider(t *testing.T) {
+	provider := RateProviderFunc(func(from, to string) (float64, error) {
+		if from == "USD" && to == "GBP" {
+			return 0.8, nil
+		}
+
+		return 0, ErrNoRate
+	})
+
+	e, _ := NewExchange("USD", provider)
+	e.SetRate("USD", "EUR", 0.9)
+
+	if rate, err := e.Rate("EUR", "GBP"); err != nil || math.Abs(rate-0.8/0.9) > 1e-9 {
+		t.Errorf("Expected EUR/GBP to be derived through USD but got %v %v", rate, err)
+	}
+
+	failing := RateProviderFunc(func(from, to string) (float64, error) {
+		return 0, errors.New("feed down")
+	})
+
+	e, _ = NewExchange("USD", failing)
+
+	if _, err := e.Rate("USD", "GBP"); err == nil || errors.Is(err, ErrNoRate) {
+		t.Errorf("Expected the provider error but got %v", err)
+	}
+}
+
+func TestExchangeConvert(t *testing.T) {
+	e := sampleExchange(t)
+
+	values := []struct {
+		val      float64
+		from, to string
+		expected float64
+	}{
+		{10, "USD", "EUR", 9},
+		{10.01, "USD", "JPY", 1502},
+		{1, "USD", "BHD", 0.377},
+		{-10, "EUR", "USD", -11.11},
+	}
+
+	for _, v := range values {
+		converted, err := e.Convert(v.val, v.from, v.to)
+
+		if err != nil || converted != v.expected {
+			t.Errorf("Expected %v %s in %s to be %v but got %v %v", v.val, v.from, v.to, v.expected, converted, err)
+		}
+	}
+
+	if _, err := e.Convert(10, "USD", "GBP"); !errors.Is(err, ErrNoRate) {
+		t.Errorf("Expected ErrNoRate but got %v", err)
+	}
+}
+
+func TestExchangeFormatConverted(t *testing.T) {
+	e := sampleExchange(t)
+
+	values := []struct {
+		to       string
+		expected string
+	}{
+		{"JPY", "¥1,500"},
+		{"BHD", "ب.د3.774"},
+		{"EUR", "€9,00"},
+	}
+
+	for _, v := range values {
+		s, err := e.FormatConverted(10, "USD", v.to)
+
+		if err != nil || s != v.expected {
+			t.Errorf("Expected %s but got %s %v", v.expected, s, err)
+		}
+	}
+}
+
+func TestExchangeConvertWithTolerance(t *testing.T) {
+	e := sampleExchange(t)
+	m := Money{10000, "USD"}
+
+	if converted, err := e.ConvertWithTolerance(m, "eur", 0.9009, 10); err != nil || converted != (Money{9000, "EUR"}) {
+		t.Errorf("Expected €90,00 but got %s %v", converted, err)
+	}
+
+	if _, err := e.ConvertWithTolerance(m, "EUR", 0.91, 100); !errors.Is(err, ErrRateDeviation) {
+		t.Errorf("Expected ErrRateDeviation but got %v", err)
+	}
+
+	if _, err := e.ConvertWithTolerance(m, "EUR", 0.91, 111); err != nil {
+		t.Errorf("Expected a deviation of 109.9 bps to be tolerated but got %v", err)
+	}
+
+	for _, v := range [][2]float64{{0, 10}, {math.NaN(), 10}, {0.9, -1}, {0.9, math.Inf(1)}} {
+		if _, err := e.ConvertWithTolerance(m, "EUR", v[0], v[1]); err == nil {
+			t.Errorf("Expected reference %v and tolerance %v to be rejected", v[0], v[1])
+		}
+	}
+
+	if _, err := e.ConvertWithTolerance(m, "GBP", 0.8, 10); !errors.Is(err, ErrNoRate) {
+		t.Errorf("Expected ErrNoRate but got %v", err)
+	}
+}
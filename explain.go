@@ -0,0 +1,101 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// RateSource is where an Exchange found the rate of a RateLeg
+type RateSource int
+
+const (
+	// RateSet is a rate set with SetPairRate
+	RateSet RateSource = iota
+
+	// RateInverse is the inverse of a rate set for the opposite pair
+	RateInverse
+
+	// RateProvided is a rate from the provider of the exchange
+	RateProvided
+
+	// RateIdentity is the rate of 1 between a currency and itself
+	RateIdentity
+)
+
+var rateSourceNames = []string{"set", "inverse", "provider", "identity"}
+
+// String returns the name of the source, e.g. "inverse"
+func (s RateSource) String() string {
+	if s < 0 || int(s) >= len(rateSourceNames) {
+		return fmt.Sprintf("RateSource(%d)", int(s))
+	}
+
+	return rateSourceNames[s]
+}
+
+// RateLeg is a rate a conversion was derived from
+type RateLeg struct {
+	Pair   Pair
+	Rate   float64
+	Source RateSource
+}
+
+// Explanation is how an Exchange converts an amount: the legs of the rate, one
+// or two through the base currency of the exchange, their float64 product, the
+// exact converted amount in minor units before rounding and the result, rounded
+// half away from zero to minor units as ConvertMoney does
+type Explanation struct {
+	Amount    Money
+	Legs      []RateLeg
+	Rate      float64
+	Unrounded *big.Rat
+	Result    Money
+}
+
+// Explain returns how ConvertMoney converts m into to, without converting it.
+// Rates are looked up as ConvertMoney looks them up, so the provider of the
+// exchange is queried for pairs not set on it. It fails as ConvertMoney does.
+func (e *Exchange) Explain(m Money, to string) (x Explanation, err error) {
+	defer guard(&err)
+
+	to = strings.ToUpper(to)
+	rate, legs, err := e.resolve(Pair{m.currency, to})
+
+	if err != nil {
+		return Explanation{}, err
+	}
+
+	unrounded := new(big.Rat).SetInt64(m.amount)
+	unrounded.Mul(unrounded, decimalRat(rate))
+	unrounded.Mul(unrounded, big.NewRat(currencies[to].units(), currencies[m.currency].units()))
+
+	result, err := convertUnits(m, to, decimalRat(rate))
+
+	if err != nil {
+		return Explanation{}, err
+	}
+
+	return Explanation{m, legs, rate, unrounded, result}, nil
+}
+
+// String returns the explanation as lines of text, e.g.
+//
+//	$100.00 to EUR
+//	  USD/EUR 0.92 (set)
+//	  rate 0.92
+//	  9200 minor units, rounded to €92,00
+func (x Explanation) String() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s to %s\n", x.Amount, x.Result.currency)
+
+	for _, leg := range x.Legs {
+		fmt.Fprintf(&b, "  %s %v (%s)\n", leg.Pair, leg.Rate, leg.Source)
+	}
+
+	fmt.Fprintf(&b, "  rate %v\n", x.Rate)
+	fmt.Fprintf(&b, "  %s minor units, rounded to %s", strings.TrimRight(strings.TrimRight(x.Unrounded.FloatString(8), "0"), "."), x.Result)
+
+	return b.String()
+}
@@ -0,0 +1,64 @@
+package money
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestExchangeExplain(t *testing.T) {
+	provider := RateProviderFunc(func(from, to string) (float64, error) {
+		if from == "USD" && to == "JPY" {
+			return 150.5, nil
+		}
+
+		return 0, ErrNoRate
+	})
+
+	e, _ := NewExchange("USD", provider)
+	e.SetPairRate(Pair{"USD", "EUR"}, 0.8)
+
+	values := []struct {
+		m        Money
+		to       string
+		legs     []RateLeg
+		result   Money
+		rendered string
+	}{
+		{Money{10000, "USD"}, "eur", []RateLeg{{Pair{"USD", "EUR"}, 0.8, RateSet}}, Money{8000, "EUR"},
+			"$100.00 to EUR\n  USD/EUR 0.8 (set)\n  rate 0.8\n  8000 minor units, rounded to €80,00"},
+		{Money{1001, "EUR"}, "USD", []RateLeg{{Pair{"EUR", "USD"}, 1.25, RateInverse}}, Money{1251, "USD"},
+			"€10,01 to USD\n  EUR/USD 1.25 (inverse)\n  rate 1.25\n  1251.25 minor units, rounded to $12.51"},
+		{Money{100, "EUR"}, "JPY", []RateLeg{{Pair{"EUR", "USD"}, 1.25, RateInverse}, {Pair{"USD", "JPY"}, 150.5, RateProvided}}, Money{188, "JPY"},
+			"€1,00 to JPY\n  EUR/USD 1.25 (inverse)\n  USD/JPY 150.5 (provider)\n  rate 188.125\n  188.125 minor units, rounded to ¥188"},
+		{Money{100, "USD"}, "USD", []RateLeg{{Pair{"USD", "USD"}, 1, RateIdentity}}, Money{100, "USD"},
+			"$1.00 to USD\n  USD/USD 1 (identity)\n  rate 1\n  100 minor units, rounded to $1.00"},
+	}
+
+	for _, v := range values {
+		x, err := e.Explain(v.m, v.to)
+
+		if err != nil || !reflect.DeepEqual(x.Legs, v.legs) || x.Result != v.result {
+			t.Errorf("Expected %v and %s but got %+v %v", v.legs, v.result, x, err)
+			continue
+		}
+
+		if converted, _ := e.ConvertMoney(v.m, v.to); converted != x.Result {
+			t.Errorf("Expected the explained result %s to be converted but got %s", x.Result, converted)
+		}
+
+		if s := x.String(); s != v.rendered {
+			t.Errorf("Expected %q but got %q", v.rendered, s)
+		}
+	}
+
+	if _, err := e.Explain(Money{100, "EUR"}, "GBP"); !errors.Is(err, ErrNoRate) {
+		t.Errorf("Expected ErrNoRate but got %v", err)
+	}
+}
+
+func TestRateSourceString(t *testing.T) {
+	if s := RateSource(9).String(); s != "RateSource(9)" {
+		t.Errorf("Expected RateSource(9) but got %s", s)
+	}
+}
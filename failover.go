@@ -0,0 +1,172 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultBreakerFailures is the number of consecutive failures opening the
+	// circuit of a provider
+	defaultBreakerFailures = 3
+
+	// defaultBreakerCooldown is the time the circuit of a provider stays open
+	defaultBreakerCooldown = 30 * time.Second
+
+	// latencyWeight is the weight of the latest call in the moving average of
+	// the latency of a provider
+	latencyWeight = 0.2
+)
+
+// ProviderHealth is the health of a provider of a Failover
+type ProviderHealth struct {
+	Successes           uint64
+	Failures            uint64
+	ConsecutiveFailures int
+
+	// Latency is the exponentially weighted moving average of the calls
+	Latency time.Duration
+
+	// LastError is the error of the last failed call
+	LastError error
+
+	// Open reports whether calls to the provider are skipped after failures
+	Open bool
+}
+
+// Failover is a RateProvider trying its providers in order until one returns a
+// rate, e.g. a live feed, then a cached table. A provider failing with ErrNoRate
+// does not know the pair; other failures count against its health, and after
+// consecutive ones its circuit opens: it is skipped for a cooldown, then tried
+// again by a single call closing the circuit on success. It is safe for
+// concurrent use.
+type Failover struct {
+	mu        sync.Mutex
+	providers []RateProvider
+	health    []ProviderHealth
+	openedAt  []time.Time
+	failures  int
+	cooldown  time.Duration
+	now       func() time.Time
+}
+
+// NewFailover returns a Failover over providers, opening the circuit of a
+// provider after 3 consecutive failures for 30 seconds
+func NewFailover(providers ...RateProvider) *Failover {
+	return &Failover{
+		providers: providers,
+		health:    make([]ProviderHealth, len(providers)),
+		openedAt:  make([]time.Time, len(providers)),
+		failures:  defaultBreakerFailures,
+		cooldown:  defaultBreakerCooldown,
+		now:       time.Now,
+	}
+}
+
+// SetBreaker sets the number of consecutive failures opening the circuit of a
+// provider and the time it stays open, failing when either is not positive
+func (f *Failover) SetBreaker(failures int, cooldown time.Duration) error {
+	if failures <= 0 || cooldown <= 0 {
+		return fmt.Errorf("money: invalid circuit breaker of %d failures and %s", failures, cooldown)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.failures, f.cooldown = failures, cooldown
+
+	return nil
+}
+
+// Rate returns the rate of the first provider knowing the pair. It fails with
+// ErrNoRate when no provider knows it, and with ErrProvidersDown, wrapping the
+// last failure, when the others failed or were skipped.
+func (f *Failover) Rate(from, to string) (float64, error) {
+	var last error
+	down := false
+
+	for i, provider := range f.providers {
+		if !f.allow(i) {
+			down = true
+			continue
+		}
+
+		start := f.now()
+		rate, err := provider.Rate(from, to)
+		f.record(i, f.now().Sub(start), err)
+
+		if err == nil {
+			return rate, nil
+		}
+
+		if !errors.Is(err, ErrNoRate) {
+			down, last = true, err
+		}
+	}
+
+	switch {
+	case last != nil:
+		return 0, fmt.Errorf("%w for %s/%s: %w", ErrProvidersDown, from, to, last)
+	case down:
+		return 0, fmt.Errorf("%w for %s/%s", ErrProvidersDown, from, to)
+	}
+
+	return 0, fmt.Errorf("%w for %s/%s", ErrNoRate, from, to)
+}
+
+// Health returns the health of the providers, in their order
+func (f *Failover) Health() []ProviderHealth {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]ProviderHealth(nil), f.health...)
+}
+
+// allow reports whether provider i may be called, letting a single call through
+// once its circuit has been open for the cooldown
+func (f *Failover) allow(i int) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !f.health[i].Open {
+		return true
+	}
+
+	if now := f.now(); now.Sub(f.openedAt[i]) >= f.cooldown {
+		// the trial call holds the circuit open for another cooldown
+		f.openedAt[i] = now
+		return true
+	}
+
+	return false
+}
+
+// record updates the health of provider i after a call
+func (f *Failover) record(i int, latency time.Duration, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	h := &f.health[i]
+
+	if h.Successes+h.Failures == 0 {
+		h.Latency = latency
+	} else {
+		h.Latency = time.Duration(latencyWeight*float64(latency) + (1-latencyWeight)*float64(h.Latency))
+	}
+
+	if err == nil || errors.Is(err, ErrNoRate) {
+		h.Successes++
+		h.ConsecutiveFailures, h.Open = 0, false
+		return
+	}
+
+	h.Failures++
+	h.ConsecutiveFailures++
+	h.LastError = err
+
+	if h.ConsecutiveFailures >= f.failures && !h.Open {
+		h.Open, f.openedAt[i] = true, f.now()
+	}
+}
@@ -0,0 +1,92 @@
+package money
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFailover(t *testing.T) {
+	clock := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	outage := errors.New("feed unreachable")
+	calls := 0
+
+	live := RateProviderFunc(func(from, to string) (float64, error) {
+		calls++
+		clock = clock.Add(10 * time.Millisecond)
+
+		if outage != nil {
+			return 0, outage
+		}
+
+		return 1.1, nil
+	})
+
+	static := RateProviderFunc(func(from, to string) (float64, error) {
+		if from == "EUR" && to == "USD" {
+			return 1.08, nil
+		}
+
+		return 0, ErrNoRate
+	})
+
+	f := NewFailover(live, static)
+	f.now = func() time.Time { return clock }
+
+	if err := f.SetBreaker(2, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if rate, err := f.Rate("EUR", "USD"); err != nil || rate != 1.08 {
+			t.Errorf("Expected the static rate but got %v %v", rate, err)
+		}
+	}
+
+	if calls != 2 {
+		t.Errorf("Expected the open circuit to skip the live feed but got %d calls", calls)
+	}
+
+	health := f.Health()
+
+	if h := health[0]; !h.Open || h.Failures != 2 || h.Latency != 10*time.Millisecond || !errors.Is(h.LastError, outage) {
+		t.Errorf("Expected the live feed to be open but got %+v", h)
+	}
+
+	if h := health[1]; h.Open || h.Successes != 3 {
+		t.Errorf("Expected the static table to be healthy but got %+v", h)
+	}
+
+	if _, err := f.Rate("EUR", "GBP"); !errors.Is(err, ErrProvidersDown) {
+		t.Errorf("Expected ErrProvidersDown but got %v", err)
+	}
+
+	// after the cooldown a single trial call closes the circuit
+	clock = clock.Add(time.Minute)
+	outage = nil
+
+	if rate, err := f.Rate("EUR", "USD"); err != nil || rate != 1.1 || calls != 3 {
+		t.Errorf("Expected the live rate but got %v %v after %d calls", rate, err, calls)
+	}
+
+	if h := f.Health()[0]; h.Open || h.ConsecutiveFailures != 0 || h.Successes != 1 {
+		t.Errorf("Expected the live feed to be closed but got %+v", h)
+	}
+}
+
+func TestFailoverWhenFailing(t *testing.T) {
+	failure := errors.New("timeout")
+	f := NewFailover(RateProviderFunc(func(from, to string) (float64, error) { return 0, failure }))
+
+	if _, err := f.Rate("EUR", "USD"); !errors.Is(err, ErrProvidersDown) || !errors.Is(err, failure) {
+		t.Errorf("Expected ErrProvidersDown wrapping the failure but got %v", err)
+	}
+
+	if _, err := NewFailover().Rate("EUR", "USD"); !errors.Is(err, ErrNoRate) {
+		t.Errorf("Expected ErrNoRate but got %v", err)
+	}
+
+	if err := f.SetBreaker(0, time.Second); err == nil {
+		t.Error("Expected an invalid breaker to be rejected")
+	}
+}
@@ -0,0 +1,242 @@
+package money
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// FiscalCalendar divides time into fiscal years, quarters and periods. Years are
+// named after the calendar year they end in, so with a StartMonth of April the
+// fiscal year 2027 runs from April 2026 to March 2027.
+type FiscalCalendar struct {
+	// StartMonth is the first month of the fiscal year, January when zero
+	StartMonth time.Month
+
+	// Weeks, when set, are the weeks of the periods of each quarter, e.g.
+	// {4, 4, 5}, and years end on EndWeekday in the month before StartMonth;
+	// years of 53 weeks add the extra week to their last period. When zero,
+	// periods are calendar months.
+	Weeks [3]int
+
+	// EndWeekday is the weekday week based years end on
+	EndWeekday time.Weekday
+
+	// Nearest ends week based years on the EndWeekday nearest to the end of the
+	// month instead of the last one in it
+	Nearest bool
+}
+
+// FiscalPeriod is a period of a FiscalCalendar, from Start up to End, at
+// midnight UTC. Period is 1 to 12, or the ISO week for ISOWeeks totals, and is
+// zero for quarters and years, as Quarter is for years.
+type FiscalPeriod struct {
+	Year    int
+	Quarter int
+	Period  int
+	Start   time.Time
+	End     time.Time
+}
+
+// PeriodGranularity selects the periods of PeriodTotals
+type PeriodGranularity int
+
+const (
+	// FiscalPeriods totals each period of twelve in a year
+	FiscalPeriods PeriodGranularity = iota
+
+	// FiscalQuarters totals each quarter
+	FiscalQuarters
+
+	// FiscalYears totals each year
+	FiscalYears
+
+	// ISOWeeks totals each ISO 8601 week, ignoring the calendar
+	ISOWeeks
+)
+
+// PeriodTotal is the total and the count of the flows of a period
+type PeriodTotal struct {
+	Period FiscalPeriod
+	Total  Money
+	Count  int
+}
+
+// PeriodOf returns the period of the calendar t falls in, by the date of t in
+// its location
+func (c FiscalCalendar) PeriodOf(t time.Time) (FiscalPeriod, error) {
+	if err := c.validate(); err != nil {
+		return FiscalPeriod{}, err
+	}
+
+	return c.periodOf(t, FiscalPeriods), nil
+}
+
+// PeriodTotals sums flows per period of the calendar, returning the periods
+// with flows by date. It fails for an invalid calendar or granularity, and for
+// flows in different currencies or totals overflowing.
+func PeriodTotals(flows []CashFlow, c FiscalCalendar, by PeriodGranularity) ([]PeriodTotal, error) {
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+
+	if by < FiscalPeriods || by > ISOWeeks {
+		return nil, fmt.Errorf("money: invalid granularity %d", by)
+	}
+
+	if len(flows) == 0 {
+		return nil, nil
+	}
+
+	if _, err := checkFlows(flows); err != nil {
+		return nil, err
+	}
+
+	totals := map[time.Time]*PeriodTotal{}
+
+	for _, flow := range flows {
+		p := c.periodOf(flow.Date, by)
+		total, ok := totals[p.Start]
+
+		if !ok {
+			totals[p.Start] = &PeriodTotal{p, flow.Amount, 1}
+			continue
+		}
+
+		sum, err := total.Total.Add(flow.Amount)
+
+		if err != nil {
+			return nil, err
+		}
+
+		total.Total, total.Count = sum, total.Count+1
+	}
+
+	result := make([]PeriodTotal, 0, len(totals))
+
+	for _, total := range totals {
+		result = append(result, *total)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Period.Start.Before(result[j].Period.Start) })
+
+	return result, nil
+}
+
+// validate ensures the calendar has a valid month, weekday and pattern of weeks
+func (c FiscalCalendar) validate() error {
+	if c.StartMonth < 0 || c.StartMonth > time.December || c.EndWeekday < time.Sunday || c.EndWeekday > time.Saturday {
+		return fmt.Errorf("money: invalid fiscal calendar %+v", c)
+	}
+
+	if c.Weeks != ([3]int{}) {
+		for _, w := range c.Weeks {
+			if w != 4 && w != 5 {
+				return fmt.Errorf("money: invalid fiscal calendar weeks %v", c.Weeks)
+			}
+		}
+
+		if c.Weeks[0]+c.Weeks[1]+c.Weeks[2] != 13 {
+			return fmt.Errorf("money: invalid fiscal calendar weeks %v", c.Weeks)
+		}
+	}
+
+	return nil
+}
+
+// startMonth returns StartMonth, January when zero
+func (c FiscalCalendar) startMonth() time.Month {
+	if c.StartMonth == 0 {
+		return time.January
+	}
+
+	return c.StartMonth
+}
+
+// periodOf returns the period of the granularity t falls in, by the date of t in
+// its location
+func (c FiscalCalendar) periodOf(t time.Time, by PeriodGranularity) FiscalPeriod {
+	date := civilDay(t)
+	year := date.Year()
+
+	if by == ISOWeeks {
+		isoYear, week := date.ISOWeek()
+
+		// ISO weeks start on Monday
+		start := date.AddDate(0, 0, -((int(date.Weekday()) + 6) % 7))
+
+		return FiscalPeriod{isoYear, 0, week, start, start.AddDate(0, 0, 7)}
+	}
+
+	// the fiscal year ending in the calendar year of date, or a year next to it
+	// for week based years ending near January
+	if !date.Before(c.bounds(year)[12]) {
+		year++
+	} else if date.Before(c.bounds(year)[0]) {
+		year--
+	}
+
+	bounds := c.bounds(year)
+	period := 1
+
+	for !date.Before(bounds[period]) {
+		period++
+	}
+
+	quarter := (period-1)/3 + 1
+
+	switch by {
+	case FiscalQuarters:
+		return FiscalPeriod{year, quarter, 0, bounds[(quarter-1)*3], bounds[quarter*3]}
+	case FiscalYears:
+		return FiscalPeriod{year, 0, 0, bounds[0], bounds[12]}
+	}
+
+	return FiscalPeriod{year, quarter, period, bounds[period-1], bounds[period]}
+}
+
+// bounds returns the starts of the twelve periods of the fiscal year and its end
+func (c FiscalCalendar) bounds(year int) (bounds [13]time.Time) {
+	if c.Weeks == ([3]int{}) {
+		start := time.Date(year, c.startMonth(), 1, 0, 0, 0, 0, time.UTC)
+
+		if c.startMonth() != time.January {
+			start = start.AddDate(-1, 0, 0)
+		}
+
+		for i := range bounds {
+			bounds[i] = start.AddDate(0, i, 0)
+		}
+
+		return bounds
+	}
+
+	bounds[0] = c.yearEnd(year - 1)
+
+	for i := 1; i < 12; i++ {
+		bounds[i] = bounds[i-1].AddDate(0, 0, 7*c.Weeks[(i-1)%3])
+	}
+
+	// the last period takes the 53rd week of long years
+	bounds[12] = c.yearEnd(year)
+
+	return bounds
+}
+
+// yearEnd returns the day after the last day of the week based fiscal year
+func (c FiscalCalendar) yearEnd(year int) time.Time {
+	// the last day of the month before StartMonth
+	last := time.Date(year, c.startMonth(), 0, 0, 0, 0, 0, time.UTC)
+
+	if c.startMonth() == time.January {
+		last = last.AddDate(1, 0, 0)
+	}
+
+	back := (int(last.Weekday()) - int(c.EndWeekday) + 7) % 7
+
+	if c.Nearest && back > 3 {
+		back -= 7
+	}
+
+	return last.AddDate(0, 0, 1-back)
+}
@@ -0,0 +1,115 @@
+package money
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func fiscalDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestFiscalCalendarPeriodOf(t *testing.T) {
+	april := FiscalCalendar{StartMonth: time.April}
+	retail := FiscalCalendar{StartMonth: time.February, Weeks: [3]int{4, 5, 4}, EndWeekday: time.Saturday, Nearest: true}
+	nearJanuary := FiscalCalendar{Weeks: [3]int{4, 4, 5}, EndWeekday: time.Saturday, Nearest: true}
+	lastFriday := FiscalCalendar{StartMonth: time.July, Weeks: [3]int{5, 4, 4}, EndWeekday: time.Friday}
+
+	values := []struct {
+		calendar FiscalCalendar
+		t        time.Time
+		expected FiscalPeriod
+	}{
+		{FiscalCalendar{}, fiscalDate(2026, 10, 14), FiscalPeriod{2026, 4, 10, fiscalDate(2026, 10, 1), fiscalDate(2026, 11, 1)}},
+		{april, fiscalDate(2026, 5, 15), FiscalPeriod{2027, 1, 2, fiscalDate(2026, 5, 1), fiscalDate(2026, 6, 1)}},
+		{april, fiscalDate(2026, 3, 31), FiscalPeriod{2026, 4, 12, fiscalDate(2026, 3, 1), fiscalDate(2026, 4, 1)}},
+		{april, time.Date(2026, 4, 1, 1, 0, 0, 0, time.FixedZone("CEST", 7200)), FiscalPeriod{2027, 1, 1, fiscalDate(2026, 4, 1), fiscalDate(2026, 5, 1)}},
+		{retail, fiscalDate(2023, 1, 29), FiscalPeriod{2024, 1, 1, fiscalDate(2023, 1, 29), fiscalDate(2023, 2, 26)}},
+		{retail, fiscalDate(2023, 3, 1), FiscalPeriod{2024, 1, 2, fiscalDate(2023, 2, 26), fiscalDate(2023, 4, 2)}},
+		{retail, fiscalDate(2024, 2, 3), FiscalPeriod{2024, 4, 12, fiscalDate(2023, 12, 31), fiscalDate(2024, 2, 4)}},
+		{retail, fiscalDate(2024, 2, 4), FiscalPeriod{2025, 1, 1, fiscalDate(2024, 2, 4), fiscalDate(2024, 3, 3)}},
+		{nearJanuary, fiscalDate(2027, 1, 2), FiscalPeriod{2026, 4, 12, fiscalDate(2026, 11, 29), fiscalDate(2027, 1, 3)}},
+		{nearJanuary, fiscalDate(2027, 1, 3), FiscalPeriod{2027, 1, 1, fiscalDate(2027, 1, 3), fiscalDate(2027, 1, 31)}},
+		{lastFriday, fiscalDate(2026, 6, 26), FiscalPeriod{2026, 4, 12, fiscalDate(2026, 5, 30), fiscalDate(2026, 6, 27)}},
+		{lastFriday, fiscalDate(2026, 6, 27), FiscalPeriod{2027, 1, 1, fiscalDate(2026, 6, 27), fiscalDate(2026, 8, 1)}},
+	}
+
+	for _, v := range values {
+		if got, err := v.calendar.PeriodOf(v.t); err != nil || got != v.expected {
+			t.Errorf("Expected %v for %s but got %v %v", v.expected, v.t, got, err)
+		}
+	}
+}
+
+func TestFiscalCalendarWhenInvalid(t *testing.T) {
+	for _, c := range []FiscalCalendar{
+		{StartMonth: 13},
+		{EndWeekday: 7},
+		{Weeks: [3]int{4, 4, 4}},
+		{Weeks: [3]int{3, 5, 5}},
+	} {
+		if _, err := c.PeriodOf(fiscalDate(2026, 1, 1)); err == nil {
+			t.Errorf("Expected %+v to be rejected", c)
+		}
+
+		if _, err := PeriodTotals(nil, c, FiscalPeriods); err == nil {
+			t.Errorf("Expected %+v to be rejected", c)
+		}
+	}
+}
+
+func TestPeriodTotals(t *testing.T) {
+	flows := []CashFlow{
+		{fiscalDate(2026, 4, 2), Money{1000, "USD"}},
+		{fiscalDate(2026, 3, 30), Money{500, "USD"}},
+		{fiscalDate(2026, 4, 20), Money{-200, "USD"}},
+		{fiscalDate(2026, 7, 1), Money{300, "USD"}},
+	}
+
+	april := FiscalCalendar{StartMonth: time.April}
+
+	values := []struct {
+		by       PeriodGranularity
+		expected []PeriodTotal
+	}{
+		{FiscalPeriods, []PeriodTotal{
+			{FiscalPeriod{2026, 4, 12, fiscalDate(2026, 3, 1), fiscalDate(2026, 4, 1)}, Money{500, "USD"}, 1},
+			{FiscalPeriod{2027, 1, 1, fiscalDate(2026, 4, 1), fiscalDate(2026, 5, 1)}, Money{800, "USD"}, 2},
+			{FiscalPeriod{2027, 2, 4, fiscalDate(2026, 7, 1), fiscalDate(2026, 8, 1)}, Money{300, "USD"}, 1},
+		}},
+		{FiscalQuarters, []PeriodTotal{
+			{FiscalPeriod{2026, 4, 0, fiscalDate(2026, 1, 1), fiscalDate(2026, 4, 1)}, Money{500, "USD"}, 1},
+			{FiscalPeriod{2027, 1, 0, fiscalDate(2026, 4, 1), fiscalDate(2026, 7, 1)}, Money{800, "USD"}, 2},
+			{FiscalPeriod{2027, 2, 0, fiscalDate(2026, 7, 1), fiscalDate(2026, 10, 1)}, Money{300, "USD"}, 1},
+		}},
+		{FiscalYears, []PeriodTotal{
+			{FiscalPeriod{2026, 0, 0, fiscalDate(2025, 4, 1), fiscalDate(2026, 4, 1)}, Money{500, "USD"}, 1},
+			{FiscalPeriod{2027, 0, 0, fiscalDate(2026, 4, 1), fiscalDate(2027, 4, 1)}, Money{1100, "USD"}, 3},
+		}},
+		{ISOWeeks, []PeriodTotal{
+			{FiscalPeriod{2026, 0, 14, fiscalDate(2026, 3, 30), fiscalDate(2026, 4, 6)}, Money{1500, "USD"}, 2},
+			{FiscalPeriod{2026, 0, 17, fiscalDate(2026, 4, 20), fiscalDate(2026, 4, 27)}, Money{-200, "USD"}, 1},
+			{FiscalPeriod{2026, 0, 27, fiscalDate(2026, 6, 29), fiscalDate(2026, 7, 6)}, Money{300, "USD"}, 1},
+		}},
+	}
+
+	for _, v := range values {
+		if got, err := PeriodTotals(flows, april, v.by); err != nil || !reflect.DeepEqual(got, v.expected) {
+			t.Errorf("Expected %v but got %v %v", v.expected, got, err)
+		}
+	}
+}
+
+func TestPeriodTotalsWhenInvalid(t *testing.T) {
+	flows := []CashFlow{{fiscalDate(2026, 4, 2), Money{1000, "USD"}}, {fiscalDate(2026, 4, 3), Money{1000, "EUR"}}}
+
+	if _, err := PeriodTotals(flows, FiscalCalendar{}, FiscalPeriods); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+
+	if _, err := PeriodTotals(flows, FiscalCalendar{}, PeriodGranularity(9)); err == nil {
+		t.Error("Expected an invalid granularity to be rejected")
+	}
+}
@@ -0,0 +1,107 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+)
+
+var strictFloats struct {
+	sync.RWMutex
+	strict bool
+	warn   func(error)
+}
+
+// SetStrictFloats guards the float64 entry points against amounts that do not
+// convert exactly, having more fraction digits than the currency, e.g. 1.005 USD
+// or 0.1+0.2 USD, so float64 call sites can be found and moved to FromMinorUnits
+// or Parse. With strict set, functions returning an error, such as FromFloat,
+// Exchange.Convert, Scope.Format and CSVWriter.Write, fail with ErrInexact for
+// them. The others, such as Format, FormatIntl and FormatRuby, and all of them
+// when strict is not set, call warn, when not nil, with the ErrInexact error and
+// round the amount as before.
+func SetStrictFloats(strict bool, warn func(error)) error {
+	return unlessFrozen(func() error {
+		strictFloats.Lock()
+		defer strictFloats.Unlock()
+
+		strictFloats.strict = strict
+		strictFloats.warn = warn
+
+		return nil
+	})
+}
+
+// inexactFloat reports val rounded to m, returning the error to fail with in
+// strict mode
+func inexactFloat(val float64, m Money) error {
+	strict, warn := floatGuard()
+
+	if !strict && warn == nil {
+		return nil
+	}
+
+	return reportInexact(fmt.Errorf("%w: %v %s rounds to %s", ErrInexact, val, m.currency, m.DecimalString()), strict, warn)
+}
+
+// exactFloat reports val when it has more fraction digits than the currency,
+// returning the error to fail with in strict mode
+func exactFloat(val float64, code string) error {
+	strict, warn := floatGuard()
+
+	if !strict && warn == nil {
+		return nil
+	}
+
+	if err := excessDigits(val, code); err != nil {
+		return reportInexact(err, strict, warn)
+	}
+
+	return nil
+}
+
+// warnInexactFloat is exactFloat for functions returning no error, which only
+// pass the error to warn, even in strict mode
+func warnInexactFloat(val float64, code string) {
+	if _, warn := floatGuard(); warn != nil {
+		if err := excessDigits(val, code); err != nil {
+			warn(err)
+		}
+	}
+}
+
+// excessDigits returns the ErrInexact error for val when it has more fraction
+// digits than the currency
+func excessDigits(val float64, code string) error {
+	c, ok := currencies[code]
+
+	if !ok || math.IsNaN(val) || math.IsInf(val, 0) {
+		return nil
+	}
+
+	if _, fractional := splitDecimal(strconv.FormatFloat(val, 'f', -1, 64)); len(fractional) <= c.exponent() {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %v %s has more than %d fraction digits", ErrInexact, val, code, c.exponent())
+}
+
+// floatGuard returns the SetStrictFloats settings
+func floatGuard() (bool, func(error)) {
+	strictFloats.RLock()
+	defer strictFloats.RUnlock()
+
+	return strictFloats.strict, strictFloats.warn
+}
+
+// reportInexact returns err in strict mode and passes it to warn otherwise
+func reportInexact(err error, strict bool, warn func(error)) error {
+	if strict {
+		return err
+	}
+
+	warn(err)
+
+	return nil
+}
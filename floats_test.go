@@ -0,0 +1,115 @@
+package money
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestSetStrictFloats(t *testing.T) {
+	defer SetStrictFloats(false, nil)
+
+	var warnings []error
+
+	SetStrictFloats(false, func(err error) { warnings = append(warnings, err) })
+
+	if m, err := FromFloat(1.005, "USD"); err != nil || m != (Money{101, "USD"}) {
+		t.Errorf("Expected $1.01 but got %s (%v)", m, err)
+	}
+
+	for _, v := range []float64{1.5, 0.1, 1.10, -100, 1e10} {
+		FromFloat(v, "USD")
+	}
+
+	FromFloat(1.5, "JPY")
+
+	if len(warnings) != 2 || warnings[0].Error() != "money: inexact amount: 1.005 USD rounds to 1.01" {
+		t.Errorf("Expected warnings for 1.005 USD and 1.5 JPY but got %v", warnings)
+	}
+
+	SetStrictFloats(true, nil)
+
+	if _, err := FromFloat(-0.125, "EUR"); !errors.Is(err, ErrInexact) {
+		t.Errorf("Expected ErrInexact but got %v", err)
+	}
+
+	if m, err := FromFloat(0.12, "EUR"); err != nil || m != (Money{12, "EUR"}) {
+		t.Errorf("Expected €0,12 but got %s (%v)", m, err)
+	}
+
+	SetStrictFloats(false, nil)
+
+	if m, err := FromFloat(-0.125, "EUR"); err != nil || m != (Money{-13, "EUR"}) {
+		t.Errorf("Expected -€0,13 but got %s (%v)", m, err)
+	}
+}
+
+func TestSetStrictFloatsWithFloatEntryPoints(t *testing.T) {
+	defer SetStrictFloats(false, nil)
+
+	// the sum of variables is inexact, unlike the constant expression 0.1 + 0.2
+	a, b := 0.1, 0.2
+	sum := a + b
+
+	var warnings []error
+
+	SetStrictFloats(false, func(err error) { warnings = append(warnings, err) })
+
+	if result := Format(sum); result != "$0.30" {
+		t.Errorf("Expected $0.30 but got %s", result)
+	}
+
+	FormatIntl(1.5, Options{"currency": "JPY"})
+	FormatRuby(1.005)
+	Format(1.25, Options{"currency": "EUR"})
+
+	if len(warnings) != 3 || warnings[0].Error() != "money: inexact amount: 0.30000000000000004 USD has more than 2 fraction digits" {
+		t.Errorf("Expected warnings for 0.1+0.2 USD, 1.5 JPY and 1.005 USD but got %v", warnings)
+	}
+
+	SetStrictFloats(true, nil)
+
+	var buf bytes.Buffer
+
+	if err := NewCSVWriter(&buf, ',').Write([]string{"fee"}, 1.5, sum); !errors.Is(err, ErrInexact) {
+		t.Errorf("Expected ErrInexact but got %v", err)
+	}
+
+	s, _ := NewScope(ScopeConfig{})
+
+	if _, err := s.Format(1.005); !errors.Is(err, ErrInexact) {
+		t.Errorf("Expected ErrInexact but got %v", err)
+	}
+
+	if result, err := s.Format(1.5); err != nil || result != "$1.50" {
+		t.Errorf("Expected $1.50 but got %s (%v)", result, err)
+	}
+
+	for _, tc := range []struct {
+		name, expected string
+		fn             func() string
+	}{
+		{"Format", "$1.00", func() string { return Format(1.005) }},
+		{"FormatIntl", "$1.01", func() string { return FormatIntl(1.005) }},
+		{"FormatRuby", "$1.00", func() string { return FormatRuby(1.005) }},
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					t.Errorf("Expected %s not to panic in strict mode but got %v", tc.name, r)
+				}
+			}()
+
+			if result := tc.fn(); result != tc.expected {
+				t.Errorf("Expected %s to return %s but got %s", tc.name, tc.expected, result)
+			}
+		}()
+	}
+
+	warnings = nil
+	SetStrictFloats(true, func(err error) { warnings = append(warnings, err) })
+
+	if result := Format(sum); result != "$0.30" || len(warnings) != 1 || !errors.Is(warnings[0], ErrInexact) {
+		t.Errorf("Expected $0.30 and an ErrInexact warning but got %s %v", result, warnings)
+	}
+}
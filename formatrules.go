@@ -0,0 +1,206 @@
+package money
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// FormatRule changes how amounts matching all of its conditions are formatted.
+// Rules are plain data, so they can be loaded as JSON with ParseFormatRules.
+type FormatRule struct {
+	// Currencies are the ISO codes the rule matches, or all when empty
+	Currencies []string `json:"currencies,omitempty"`
+
+	// MinAbs matches amounts whose absolute value in major units is at least MinAbs
+	MinAbs *float64 `json:"min_abs,omitempty"`
+
+	// Negative matches negative amounts when true and the others when false
+	Negative *bool `json:"negative,omitempty"`
+
+	// Options override the formatting options, as with Money.Format
+	Options Options `json:"options,omitempty"`
+
+	// Compact abbreviates amounts of a thousand or more with the English short
+	// scale suffixes K, M, B and T and one decimal at most, e.g. $1.2M, in place
+	// of any registered format
+	Compact bool `json:"compact,omitempty"`
+
+	// Parentheses wraps negative amounts in parentheses instead of a minus sign
+	Parentheses bool `json:"parentheses,omitempty"`
+}
+
+// formatRule is a validated FormatRule
+type formatRule struct {
+	FormatRule
+	allowed map[string]bool
+	minAbs  *big.Rat
+}
+
+// matches reports whether m meets the conditions of the rule
+func (r formatRule) matches(m Money) bool {
+	if r.allowed != nil && !r.allowed[m.currency] {
+		return false
+	}
+
+	if r.Negative != nil && *r.Negative != (m.amount < 0) {
+		return false
+	}
+
+	if r.minAbs != nil {
+		abs := new(big.Rat).SetFrac(new(big.Int).SetUint64(absUnits(m.amount)), big.NewInt(currencies[m.currency].units()))
+
+		if abs.Cmp(r.minAbs) < 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// RuleFormatter formats Money with the options and styles of the FormatRule
+// values matching each amount. It is immutable and safe for concurrent use.
+type RuleFormatter struct {
+	options Options
+	rules   []formatRule
+}
+
+// ParseFormatRules decodes a JSON array of FormatRule values
+func ParseFormatRules(data []byte) ([]FormatRule, error) {
+	var rules []FormatRule
+
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("money: invalid format rules: %s", err)
+	}
+
+	return rules, nil
+}
+
+// CompileFormatRules returns a RuleFormatter applying, over opts, every rule
+// matching an amount in order, so later rules override the options of earlier
+// ones. It fails for unknown currencies, invalid options or a negative MinAbs.
+func CompileFormatRules(rules []FormatRule, opts ...Options) (*RuleFormatter, error) {
+	f := &RuleFormatter{options: defaults()}
+
+	if len(opts) > 0 {
+		noteDeprecated(opts[0])
+
+		if err := validate(opts[0]); err != nil {
+			return nil, err
+		}
+
+		f.options = override(f.options, opts[0])
+	}
+
+	for i, rule := range rules {
+		r := formatRule{FormatRule: rule}
+		noteDeprecated(rule.Options)
+
+		if err := validate(rule.Options); err != nil {
+			return nil, fmt.Errorf("money: format rule %d: %w", i, err)
+		}
+
+		if len(rule.Currencies) > 0 {
+			r.allowed = map[string]bool{}
+
+			for _, code := range rule.Currencies {
+				code = strings.ToUpper(code)
+
+				if _, ok := currencies[code]; !ok {
+					return nil, fmt.Errorf("money: format rule %d: %w %q", i, ErrUnknownCurrency, code)
+				}
+
+				r.allowed[code] = true
+			}
+		}
+
+		if rule.MinAbs != nil {
+			if *rule.MinAbs < 0 || math.IsNaN(*rule.MinAbs) || math.IsInf(*rule.MinAbs, 0) {
+				return nil, fmt.Errorf("money: format rule %d: invalid min_abs %v", i, *rule.MinAbs)
+			}
+
+			r.minAbs = decimalRat(*rule.MinAbs)
+		}
+
+		f.rules = append(f.rules, r)
+	}
+
+	return f, nil
+}
+
+// Format formats m with the rules matching it. Parentheses replace the leading
+// minus sign, so they are not added when a registered format puts it elsewhere.
+func (f *RuleFormatter) Format(m Money) (result string) {
+	defer guard(nil)
+
+	options := override(Options{}, f.options)
+	var compact, parentheses bool
+
+	for _, r := range f.rules {
+		if r.matches(m) {
+			options = override(options, r.Options)
+			compact = compact || r.Compact
+			parentheses = parentheses || r.Parentheses
+		}
+	}
+
+	if compact {
+		options["currency"] = m.currency
+		result = string(cachedLayout(options).appendCompact(nil, m.amount, false))
+	} else {
+		result = m.Format(options)
+	}
+
+	if parentheses && m.amount < 0 && strings.HasPrefix(result, "-") {
+		result = "(" + result[1:] + ")"
+	}
+
+	return result
+}
+
+// appendCompact appends the amount given in minor units of the layout currency,
+// abbreviated with the short scale from a thousand major units, rounded down
+// rather than half up when down is true
+func (l layout) appendCompact(dst []byte, amount int64, down bool) []byte {
+	abs := absUnits(amount)
+
+	if abs/l.units < 1000 || len(compactScales) < 2 {
+		return l.appendUnits(dst, amount)
+	}
+
+	scale, step := 0, l.units
+
+	for scale < len(compactScales)-1 && abs/step >= 1000 {
+		scale, step = scale+1, step*1000
+	}
+
+	// q counts tenths of the scale
+	tenth := step / 10
+	q := abs / tenth
+
+	if !down && abs%tenth*2 >= tenth {
+		q++
+	}
+
+	if q >= 10000 && scale < len(compactScales)-1 {
+		scale, q = scale+1, (q+500)/1000
+	}
+
+	if amount < 0 {
+		dst = append(dst, '-')
+	}
+
+	dst = append(dst, l.prefix...)
+	dst = appendGrouped(dst, q/10, l.separator)
+
+	if q%10 != 0 {
+		dst = append(dst, l.mark...)
+		dst = append(dst, byte('0'+q%10))
+	}
+
+	dst = append(dst, compactScales[scale]...)
+
+	return l.appendSuffix(dst)
+}
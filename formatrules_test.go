@@ -0,0 +1,88 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRuleFormatter(t *testing.T) {
+	skipWithoutLocaleData(t)
+
+	rules, err := ParseFormatRules([]byte(`[
+		{"min_abs": 1000000, "compact": true},
+		{"currencies": ["eur"], "options": {"with_cents": false}},
+		{"negative": true, "parentheses": true}
+	]`))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := CompileFormatRules(rules)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values := []struct {
+		m        Money
+		expected string
+	}{
+		{Money{123456, "USD"}, "$1,234.56"},
+		{Money{-123456, "USD"}, "($1,234.56)"},
+		{Money{123456, "EUR"}, "€1.234"},
+		{Money{99999999, "USD"}, "$999,999.99"},
+		{Money{123456789, "USD"}, "$1.2M"},
+		{Money{100000000, "USD"}, "$1M"},
+		{Money{123456789, "EUR"}, "€1,2M"},
+		{Money{-250000000000, "USD"}, "($2.5B)"},
+		{Money{99999999999, "USD"}, "$1B"},
+		{Money{99999999999999999, "USD"}, "$1,000T"},
+		{Money{1000000, "JPY"}, "¥1M"},
+	}
+
+	for _, v := range values {
+		if result := f.Format(v.m); result != v.expected {
+			t.Errorf("Expected %s but got %s", v.expected, result)
+		}
+	}
+}
+
+func TestRuleFormatterOverrides(t *testing.T) {
+	f, err := CompileFormatRules([]FormatRule{
+		{Options: Options{"with_currency": true}},
+		{Currencies: []string{"USD"}, Options: Options{"with_currency": false, "with_symbol": false}},
+	}, Options{"with_cents": false})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result := f.Format(Money{123456, "USD"}); result != "1,234" {
+		t.Errorf("Expected 1,234 but got %s", result)
+	}
+
+	if result := f.Format(Money{123456, "EUR"}); result != "€1.234 EUR" {
+		t.Errorf("Expected €1.234 EUR but got %s", result)
+	}
+}
+
+func TestCompileFormatRulesWhenInvalid(t *testing.T) {
+	negative := -1.0
+
+	if _, err := CompileFormatRules([]FormatRule{{Currencies: []string{"XYZ"}}}); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+
+	if _, err := CompileFormatRules([]FormatRule{{Options: Options{"with_cents": "no"}}}); err == nil {
+		t.Errorf("Expected invalid options to fail")
+	}
+
+	if _, err := CompileFormatRules([]FormatRule{{MinAbs: &negative}}); err == nil {
+		t.Errorf("Expected a negative min_abs to fail")
+	}
+
+	if _, err := ParseFormatRules([]byte(`{"compact": true}`)); err == nil {
+		t.Errorf("Expected a JSON object to fail")
+	}
+}
@@ -0,0 +1,92 @@
+package money
+
+import (
+	"fmt"
+	"sync"
+)
+
+// FormatFunc formats a value given options already merged with the defaults
+type FormatFunc func(val float64, opts Options) string
+
+// MoneyFormatFunc formats Money given options already merged with the defaults
+type MoneyFormatFunc func(m Money, opts Options) string
+
+// namedFormat is a registered formatter, taking either a float64 or Money
+type namedFormat struct {
+	float FormatFunc
+	money MoneyFormatFunc
+}
+
+var formats = struct {
+	sync.RWMutex
+	funcs map[string]namedFormat
+}{funcs: map[string]namedFormat{}}
+
+// RegisterFormat registers a named formatter, selectable with Options{"format": name}.
+// The formatter receives the options with "format" cleared, so it may call Format itself.
+// Money.Format, Batch.Format and Template pass it Money.Float, which is exact up
+// to 2^53 minor units only; RegisterMoneyFormat registers formatters of Money.
+func RegisterFormat(name string, fn FormatFunc) error {
+	return registerFormat(name, namedFormat{float: fn})
+}
+
+// RegisterMoneyFormat is like RegisterFormat for a formatter receiving the
+// exact Money formatted. Format passes it the float64 value rounded as FromFloat
+// does, and formats the value itself when it does not fit in Money.
+func RegisterMoneyFormat(name string, fn MoneyFormatFunc) error {
+	return registerFormat(name, namedFormat{money: fn})
+}
+
+// registerFormat registers f under name unless the name is taken
+func registerFormat(name string, f namedFormat) error {
+	if name == "" || f.float == nil && f.money == nil {
+		return fmt.Errorf("money: format requires a name and a function")
+	}
+
+	return unlessFrozen(func() error {
+		formats.Lock()
+		defer formats.Unlock()
+
+		if _, ok := formats.funcs[name]; ok {
+			return fmt.Errorf("money: format %q is already registered", name)
+		}
+
+		formats.funcs[name] = f
+
+		return nil
+	})
+}
+
+// lookupFormat returns the formatter registered under name, for float64 values
+func lookupFormat(name string) (FormatFunc, bool) {
+	formats.RLock()
+	f, ok := formats.funcs[name]
+	formats.RUnlock()
+
+	if !ok || f.float != nil {
+		return f.float, ok
+	}
+
+	return func(val float64, opts Options) string {
+		m, err := roundFloat(val, opts["currency"].(string))
+
+		if err != nil {
+			return formatFloat(val, opts)
+		}
+
+		return f.money(m, opts)
+	}, true
+}
+
+// lookupMoneyFormat returns the formatter registered under name, for Money
+func lookupMoneyFormat(name string) (MoneyFormatFunc, bool) {
+	formats.RLock()
+	f, ok := formats.funcs[name]
+	formats.RUnlock()
+
+	if !ok || f.money != nil {
+		return f.money, ok
+	}
+
+	return func(m Money, opts Options) string { return f.float(m.Float(), opts) }, true
+}
@@ -0,0 +1,104 @@
+package money
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRegisterFormat(t *testing.T) {
+	err := RegisterFormat("invoice", func(val float64, opts Options) string {
+		opts["with_currency"] = true
+		return Format(val, opts)
+	})
+
+	if err != nil {
+		t.Fatalf("Expected format to be registered but got %s", err)
+	}
+
+	currency := Format(10, Options{"format": "invoice", "currency": "EUR"})
+	expected := "€10,00 EUR"
+
+	if currency != expected {
+		t.Errorf("Expected %s but got %s", expected, currency)
+	}
+}
+
+func TestRegisterMoneyFormat(t *testing.T) {
+	err := RegisterMoneyFormat("units", func(m Money, opts Options) string {
+		return fmt.Sprintf("%d %s", m.MinorUnits(), m.Currency())
+	})
+
+	if err != nil {
+		t.Fatalf("Expected format to be registered but got %s", err)
+	}
+
+	o := Options{"format": "units"}
+	m := Money{1<<53 + 1, "USD"}
+
+	if s := m.Format(o); s != "9007199254740993 USD" {
+		t.Errorf("Expected the exact minor units but got %s", s)
+	}
+
+	batch, _ := NewBatchFromMinorUnits([]int64{m.amount}, "USD")
+
+	if s := batch.Format(o); s[0] != "9007199254740993 USD" {
+		t.Errorf("Expected the exact minor units but got %s", s[0])
+	}
+
+	if s := NewTemplate(o).Format(m); s != "9007199254740993 USD" {
+		t.Errorf("Expected the exact minor units but got %s", s)
+	}
+
+	if s := Format(10.5, o); s != "1050 USD" {
+		t.Errorf("Expected 1050 USD but got %s", s)
+	}
+
+	if s := Format(1e30, o); s != "$1,000,000,000,000,000,019,884,624,838,656.00" {
+		t.Errorf("Expected an amount beyond Money to be formatted but got %s", s)
+	}
+
+	if err := RegisterMoneyFormat("nil money", nil); err == nil {
+		t.Error("Expected format without function to be rejected")
+	}
+}
+
+func TestRegisterFormatWhenDefault(t *testing.T) {
+	defer SetDefaults(nil)
+
+	RegisterFormat("short", func(val float64, opts Options) string {
+		opts["with_cents"] = false
+		return Format(val, opts)
+	})
+
+	if err := SetDefaults(Options{"format": "short"}); err != nil {
+		t.Fatalf("Expected format to be a valid default but got %s", err)
+	}
+
+	if currency := Format(10); currency != "$10" {
+		t.Errorf("Expected $10 but got %s", currency)
+	}
+}
+
+func TestRegisterFormatWhenInvalid(t *testing.T) {
+	fn := func(val float64, opts Options) string { return "" }
+
+	if err := RegisterFormat("", fn); err == nil {
+		t.Error("Expected format without name to be rejected")
+	}
+
+	if err := RegisterFormat("nil", nil); err == nil {
+		t.Error("Expected format without function to be rejected")
+	}
+
+	RegisterFormat("twice", fn)
+
+	if err := RegisterFormat("twice", fn); err == nil {
+		t.Error("Expected format to be registered only once")
+	}
+}
+
+func TestFormatWhenUnregistered(t *testing.T) {
+	if currency := Format(10, Options{"format": "unknown"}); currency != "$10.00" {
+		t.Errorf("Expected unknown format to be ignored but got %s", currency)
+	}
+}
@@ -0,0 +1,424 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+)
+
+// maxFormulaDepth bounds the nesting of parentheses, unary minus signs and
+// round calls of a formula
+const maxFormulaDepth = 64
+
+// Formula is a pricing formula such as "round(base * qty) * (1 + tax) - discount",
+// compiled once and evaluated against named variables. It is immutable and safe
+// for concurrent use.
+//
+// Formulas have the operators + - * / with the usual precedence, parentheses,
+// decimal literals and round(x), which rounds an amount to minor units at that
+// point. Amounts add to and subtract from amounts of their currency, and
+// multiply or divide by numbers; an amount divided by another is a number.
+type Formula struct {
+	source    string
+	root      formulaNode
+	variables []string
+}
+
+// CompileFormula parses a formula, failing for syntax errors and formulas longer
+// than MaxParseLength bytes
+func CompileFormula(source string) (*Formula, error) {
+	if len(source) > MaxParseLength {
+		return nil, fmt.Errorf("money: formula %q... is longer than %d bytes", source[:16], MaxParseLength)
+	}
+
+	p := &formulaParser{source: source, names: map[string]bool{}}
+	p.next()
+
+	root, err := p.expression(0)
+
+	if err == nil && p.token != "" {
+		err = p.errorf("unexpected %q", p.token)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	f := &Formula{source: source, root: root}
+
+	for name := range p.names {
+		f.variables = append(f.variables, name)
+	}
+
+	sort.Strings(f.variables)
+
+	return f, nil
+}
+
+// Variables returns the sorted names of the variables of the formula
+func (f *Formula) Variables() []string {
+	return append([]string(nil), f.variables...)
+}
+
+// String returns the source of the formula
+func (f *Formula) String() string {
+	return f.source
+}
+
+// Evaluate returns the value of the formula for vars, rounded by mode at each
+// round call and to minor units at the end. Variables are Money, int, int64, or
+// decimals as a string or a Decimal. It fails for missing variables, mixed
+// currencies, a division by zero or a result which is not an amount of money.
+func (f *Formula) Evaluate(vars map[string]interface{}, mode RoundingMode) (Money, error) {
+	if !mode.valid() {
+		return Money{}, fmt.Errorf("money: invalid rounding mode %s", mode)
+	}
+
+	env := formulaEnv{vars: vars, mode: mode}
+	v, err := f.root.eval(&env)
+
+	if err != nil {
+		return Money{}, fmt.Errorf("money: formula %q: %w", f.source, err)
+	}
+
+	if v.currency == "" {
+		return Money{}, fmt.Errorf("money: formula %q is a number, not an amount", f.source)
+	}
+
+	units, ok := roundRatMode(v.rat, mode)
+
+	if !ok {
+		return Money{}, fmt.Errorf("money: formula %q: %w", f.source, ErrOverflow)
+	}
+
+	return Money{units, v.currency}, nil
+}
+
+// formulaValue is an exact amount in minor units of currency, or a number when
+// currency is empty
+type formulaValue struct {
+	rat      *big.Rat
+	currency string
+}
+
+// formulaEnv holds the variables and the rounding mode of an evaluation
+type formulaEnv struct {
+	vars map[string]interface{}
+	mode RoundingMode
+}
+
+// formulaNode is a node of a compiled formula
+type formulaNode interface {
+	eval(env *formulaEnv) (formulaValue, error)
+}
+
+type formulaLiteral struct {
+	rat *big.Rat
+}
+
+func (n formulaLiteral) eval(_ *formulaEnv) (formulaValue, error) {
+	return formulaValue{rat: n.rat}, nil
+}
+
+type formulaVariable struct {
+	name string
+}
+
+func (n formulaVariable) eval(env *formulaEnv) (formulaValue, error) {
+	raw, ok := env.vars[n.name]
+
+	if !ok {
+		return formulaValue{}, fmt.Errorf("missing variable %q", n.name)
+	}
+
+	switch v := raw.(type) {
+	case Money:
+		if _, ok := currencies[v.currency]; !ok {
+			return formulaValue{}, fmt.Errorf("variable %q: %w %q", n.name, ErrUnknownCurrency, v.currency)
+		}
+
+		return formulaValue{new(big.Rat).SetInt64(v.amount), v.currency}, nil
+	case int:
+		return formulaValue{rat: new(big.Rat).SetInt64(int64(v))}, nil
+	case int64:
+		return formulaValue{rat: new(big.Rat).SetInt64(v)}, nil
+	case string:
+		r, err := parseFactor(v)
+
+		if err != nil {
+			return formulaValue{}, fmt.Errorf("variable %q: %w", n.name, err)
+		}
+
+		return formulaValue{rat: r}, nil
+	case Decimal:
+		_, r, err := decimalValue(v, "")
+
+		if err != nil {
+			return formulaValue{}, fmt.Errorf("variable %q: %w", n.name, err)
+		}
+
+		return formulaValue{rat: r}, nil
+	}
+
+	return formulaValue{}, fmt.Errorf("variable %q has unsupported type %T", n.name, raw)
+}
+
+type formulaNegation struct {
+	operand formulaNode
+}
+
+func (n formulaNegation) eval(env *formulaEnv) (formulaValue, error) {
+	v, err := n.operand.eval(env)
+
+	if err != nil {
+		return formulaValue{}, err
+	}
+
+	return formulaValue{new(big.Rat).Neg(v.rat), v.currency}, nil
+}
+
+type formulaRound struct {
+	operand formulaNode
+}
+
+func (n formulaRound) eval(env *formulaEnv) (formulaValue, error) {
+	v, err := n.operand.eval(env)
+
+	if err != nil {
+		return formulaValue{}, err
+	}
+
+	if v.currency == "" {
+		return formulaValue{}, errors.New("round of a number, not an amount")
+	}
+
+	units, ok := roundRatMode(v.rat, env.mode)
+
+	if !ok {
+		return formulaValue{}, ErrOverflow
+	}
+
+	return formulaValue{new(big.Rat).SetInt64(units), v.currency}, nil
+}
+
+type formulaBinary struct {
+	op          byte
+	left, right formulaNode
+}
+
+func (n formulaBinary) eval(env *formulaEnv) (formulaValue, error) {
+	l, err := n.left.eval(env)
+
+	if err != nil {
+		return formulaValue{}, err
+	}
+
+	r, err := n.right.eval(env)
+
+	if err != nil {
+		return formulaValue{}, err
+	}
+
+	switch n.op {
+	case '+', '-':
+		if l.currency != r.currency {
+			if l.currency == "" || r.currency == "" {
+				return formulaValue{}, fmt.Errorf("%c of an amount and a number", n.op)
+			}
+
+			return formulaValue{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, l.currency, r.currency)
+		}
+
+		if n.op == '+' {
+			return formulaValue{new(big.Rat).Add(l.rat, r.rat), l.currency}, nil
+		}
+
+		return formulaValue{new(big.Rat).Sub(l.rat, r.rat), l.currency}, nil
+	case '*':
+		if l.currency != "" && r.currency != "" {
+			return formulaValue{}, errors.New("product of two amounts")
+		}
+
+		return formulaValue{new(big.Rat).Mul(l.rat, r.rat), l.currency + r.currency}, nil
+	}
+
+	if r.rat.Sign() == 0 {
+		return formulaValue{}, errors.New("division by zero")
+	}
+
+	q := new(big.Rat).Quo(l.rat, r.rat)
+
+	switch {
+	case r.currency == "":
+		return formulaValue{q, l.currency}, nil
+	case l.currency == "":
+		return formulaValue{}, errors.New("number divided by an amount")
+	case l.currency != r.currency:
+		return formulaValue{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, l.currency, r.currency)
+	}
+
+	return formulaValue{rat: q}, nil
+}
+
+// formulaParser is a recursive descent parser over the tokens of a formula
+type formulaParser struct {
+	source string
+	pos    int
+	token  string
+	names  map[string]bool
+}
+
+// next reads the next token, or "" at the end
+func (p *formulaParser) next() {
+	for p.pos < len(p.source) && strings.IndexByte(" \t\n\r", p.source[p.pos]) >= 0 {
+		p.pos++
+	}
+
+	start := p.pos
+
+	if p.pos < len(p.source) {
+		c := p.source[p.pos]
+		p.pos++
+
+		switch {
+		case isFormulaDigit(c) || c == '.':
+			for p.pos < len(p.source) && (isFormulaDigit(p.source[p.pos]) || p.source[p.pos] == '.') {
+				p.pos++
+			}
+
+			// an exponent, e.g. 1.5e-3
+			if p.pos < len(p.source) && (p.source[p.pos] == 'e' || p.source[p.pos] == 'E') {
+				p.pos++
+
+				if p.pos < len(p.source) && (p.source[p.pos] == '+' || p.source[p.pos] == '-') {
+					p.pos++
+				}
+
+				for p.pos < len(p.source) && isFormulaDigit(p.source[p.pos]) {
+					p.pos++
+				}
+			}
+		case isFormulaLetter(c):
+			for p.pos < len(p.source) && (isFormulaLetter(p.source[p.pos]) || isFormulaDigit(p.source[p.pos])) {
+				p.pos++
+			}
+		}
+	}
+
+	p.token = p.source[start:p.pos]
+}
+
+// expression parses terms separated by + and -
+func (p *formulaParser) expression(depth int) (formulaNode, error) {
+	left, err := p.term(depth)
+
+	for err == nil && (p.token == "+" || p.token == "-") {
+		op := p.token[0]
+		p.next()
+
+		var right formulaNode
+
+		if right, err = p.term(depth); err == nil {
+			left = formulaBinary{op, left, right}
+		}
+	}
+
+	return left, err
+}
+
+// term parses factors separated by * and /
+func (p *formulaParser) term(depth int) (formulaNode, error) {
+	left, err := p.factor(depth)
+
+	for err == nil && (p.token == "*" || p.token == "/") {
+		op := p.token[0]
+		p.next()
+
+		var right formulaNode
+
+		if right, err = p.factor(depth); err == nil {
+			left = formulaBinary{op, left, right}
+		}
+	}
+
+	return left, err
+}
+
+// factor parses a literal, a variable, a negation, a round call or a
+// parenthesized expression
+func (p *formulaParser) factor(depth int) (formulaNode, error) {
+	if depth >= maxFormulaDepth {
+		return nil, p.errorf("nested deeper than %d", maxFormulaDepth)
+	}
+
+	token := p.token
+
+	switch {
+	case token == "":
+		return nil, p.errorf("unexpected end")
+	case token == "-":
+		p.next()
+		operand, err := p.factor(depth + 1)
+
+		return formulaNegation{operand}, err
+	case token == "(":
+		p.next()
+		return p.parenthesized(depth + 1)
+	case token == "round":
+		p.next()
+
+		if p.token != "(" {
+			return nil, p.errorf("expected ( after round")
+		}
+
+		p.next()
+		operand, err := p.parenthesized(depth + 1)
+
+		return formulaRound{operand}, err
+	case isFormulaDigit(token[0]) || token[0] == '.':
+		r, err := parseFactor(token)
+
+		if err != nil {
+			return nil, p.errorf("invalid number %q", token)
+		}
+
+		p.next()
+
+		return formulaLiteral{r}, nil
+	case isFormulaLetter(token[0]):
+		p.names[token] = true
+		p.next()
+
+		return formulaVariable{token}, nil
+	}
+
+	return nil, p.errorf("unexpected %q", token)
+}
+
+// parenthesized parses an expression followed by a closing parenthesis
+func (p *formulaParser) parenthesized(depth int) (formulaNode, error) {
+	node, err := p.expression(depth)
+
+	if err == nil && p.token != ")" {
+		err = p.errorf("expected )")
+	}
+
+	p.next()
+
+	return node, err
+}
+
+// errorf returns a syntax error at the current token
+func (p *formulaParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("money: invalid formula %q at offset %d: %s", p.source, p.pos-len(p.token), fmt.Sprintf(format, args...))
+}
+
+func isFormulaDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isFormulaLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_'
+}
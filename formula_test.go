@@ -0,0 +1,110 @@
+package money
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFormula(t *testing.T) {
+	vars := map[string]interface{}{
+		"base":     Money{1999, "USD"},
+		"qty":      3,
+		"tax":      "0.0825",
+		"discount": Money{500, "USD"},
+		"share":    int64(2),
+	}
+
+	values := []struct {
+		source   string
+		mode     RoundingMode
+		expected Money
+	}{
+		{"base * qty * (1 + tax) - discount", HalfUp, Money{5992, "USD"}},
+		{"round(base * tax) * qty", HalfUp, Money{495, "USD"}},
+		{"base * tax * qty", HalfUp, Money{495, "USD"}},
+		{"base / share", HalfEven, Money{1000, "USD"}},
+		{"base / share", TowardZero, Money{999, "USD"}},
+		{"-discount + base", HalfUp, Money{1499, "USD"}},
+		{"base * (discount / base)", HalfUp, Money{500, "USD"}},
+		{"base * 1.5e-1", HalfUp, Money{300, "USD"}},
+	}
+
+	for _, v := range values {
+		f, err := CompileFormula(v.source)
+
+		if err != nil {
+			t.Errorf("Expected %q to compile but got %v", v.source, err)
+			continue
+		}
+
+		if got, err := f.Evaluate(vars, v.mode); err != nil || got != v.expected {
+			t.Errorf("Expected %q to be %s but got %s %v", v.source, v.expected, got, err)
+		}
+	}
+}
+
+func TestFormulaVariables(t *testing.T) {
+	f, _ := CompileFormula("round(base * qty) * (1 + tax) - base")
+
+	if got := f.Variables(); !reflect.DeepEqual(got, []string{"base", "qty", "tax"}) {
+		t.Errorf("Expected [base qty tax] but got %v", got)
+	}
+
+	if s := f.String(); s != "round(base * qty) * (1 + tax) - base" {
+		t.Errorf("Expected the source but got %s", s)
+	}
+}
+
+func TestCompileFormulaWhenInvalid(t *testing.T) {
+	for _, source := range []string{"", "base *", "(base", "base)", "round base", "1..2", "base $ 2", strings.Repeat("(", 70) + "1" + strings.Repeat(")", 70), strings.Repeat("1+", 200)} {
+		if _, err := CompileFormula(source); err == nil {
+			t.Errorf("Expected %q to be rejected", source)
+		}
+	}
+}
+
+func TestFormulaEvaluateWhenInvalid(t *testing.T) {
+	vars := map[string]interface{}{
+		"usd":  Money{100, "USD"},
+		"eur":  Money{100, "EUR"},
+		"zero": 0,
+		"bad":  1.5,
+	}
+
+	values := []struct {
+		source string
+		target error
+	}{
+		{"usd + eur", ErrCurrencyMismatch},
+		{"usd / eur", ErrCurrencyMismatch},
+		{"usd + 1", nil},
+		{"usd * usd", nil},
+		{"2 / usd", nil},
+		{"usd / zero", nil},
+		{"usd / usd", nil},
+		{"round(2)", nil},
+		{"usd * missing", nil},
+		{"usd * bad", nil},
+	}
+
+	for _, v := range values {
+		f, err := CompileFormula(v.source)
+
+		if err != nil {
+			t.Errorf("Expected %q to compile but got %v", v.source, err)
+			continue
+		}
+
+		if _, err := f.Evaluate(vars, HalfUp); err == nil || v.target != nil && !errors.Is(err, v.target) {
+			t.Errorf("Expected %q to fail with %v but got %v", v.source, v.target, err)
+		}
+	}
+
+	f, _ := CompileFormula("usd")
+
+	if _, err := f.Evaluate(vars, RoundingMode(-1)); err == nil {
+		t.Error("Expected an invalid rounding mode to be rejected")
+	}
+}
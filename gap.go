@@ -0,0 +1,36 @@
+package money
+
+import (
+	"strings"
+)
+
+// GapTo returns the amount current falls short of threshold, or zero once the
+// threshold is reached, e.g. the spend left to qualify for free shipping
+func GapTo(threshold, current Money) (Money, error) {
+	gap, err := threshold.Subtract(current)
+
+	if err != nil {
+		return Money{}, err
+	}
+
+	if gap.IsNegative() {
+		return Money{0, gap.currency}, nil
+	}
+
+	return gap, nil
+}
+
+// FormatGap returns message with "{amount}" replaced by the formatted gap to
+// threshold, e.g. "Add {amount} more for free shipping", or an empty string once
+// the threshold is reached. Translated messages may place "{amount}" anywhere.
+func FormatGap(threshold, current Money, message string, opts ...Options) (string, error) {
+	noteDeprecated(opts...)
+
+	gap, err := GapTo(threshold, current)
+
+	if err != nil || gap.IsZero() {
+		return "", err
+	}
+
+	return strings.ReplaceAll(message, "{amount}", gap.Format(opts...)), nil
+}
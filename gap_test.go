@@ -0,0 +1,46 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGapTo(t *testing.T) {
+	values := []struct {
+		threshold, current Money
+		expected           Money
+	}{
+		{Money{5000, "USD"}, Money{3750, "USD"}, Money{1250, "USD"}},
+		{Money{5000, "USD"}, Money{5000, "USD"}, Money{0, "USD"}},
+		{Money{5000, "USD"}, Money{6000, "USD"}, Money{0, "USD"}},
+	}
+
+	for _, v := range values {
+		if gap, err := GapTo(v.threshold, v.current); err != nil || gap != v.expected {
+			t.Errorf("Expected %s but got %s %v", v.expected, gap, err)
+		}
+	}
+
+	if _, err := GapTo(Money{5000, "USD"}, Money{100, "EUR"}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+}
+
+func TestFormatGap(t *testing.T) {
+	values := []struct {
+		current  Money
+		message  string
+		options  Options
+		expected string
+	}{
+		{Money{3750, "USD"}, "Add {amount} more for free shipping", Options{}, "Add $12.50 more for free shipping"},
+		{Money{3750, "USD"}, "Noch {amount} bis zum kostenlosen Versand", Options{"with_currency": true}, "Noch $12.50 USD bis zum kostenlosen Versand"},
+		{Money{5000, "USD"}, "Add {amount} more for free shipping", Options{}, ""},
+	}
+
+	for _, v := range values {
+		if s, err := FormatGap(Money{5000, "USD"}, v.current, v.message, v.options); err != nil || s != v.expected {
+			t.Errorf("Expected %q but got %q %v", v.expected, s, err)
+		}
+	}
+}
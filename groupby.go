@@ -0,0 +1,50 @@
+package money
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Group is the total and the count of the items of a key of GroupBy
+type Group struct {
+	Total Money
+	Count int
+}
+
+// GroupBy sums the items of a slice per key, in the manner of sort.Slice: key
+// and amount return the key and the amount of the item at index i, e.g.
+//
+//	groups, err := money.GroupBy(orders,
+//		func(i int) string { return orders[i].Region },
+//		func(i int) money.Money { return orders[i].Total })
+//
+// It fails when items is not a slice, when the amounts of a key are in
+// different currencies or when a total overflows.
+func GroupBy(items interface{}, key func(i int) string, amount func(i int) Money) (map[string]Group, error) {
+	v := reflect.ValueOf(items)
+
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, fmt.Errorf("money: cannot group %T, not a slice", items)
+	}
+
+	groups := map[string]Group{}
+
+	for i := 0; i < v.Len(); i++ {
+		k, m := key(i), amount(i)
+		g, ok := groups[k]
+
+		if ok {
+			total, err := g.Total.Add(m)
+
+			if err != nil {
+				return nil, fmt.Errorf("money: group %q: %w", k, err)
+			}
+
+			m = total
+		}
+
+		groups[k] = Group{m, g.Count + 1}
+	}
+
+	return groups, nil
+}
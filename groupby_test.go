@@ -0,0 +1,57 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestGroupBy(t *testing.T) {
+	orders := []struct {
+		region string
+		total  Money
+	}{
+		{"eu", Money{1000, "EUR"}},
+		{"us", Money{250, "USD"}},
+		{"eu", Money{-300, "EUR"}},
+		{"us", Money{125, "USD"}},
+		{"eu", Money{50, "EUR"}},
+	}
+
+	groups, err := GroupBy(orders,
+		func(i int) string { return orders[i].region },
+		func(i int) Money { return orders[i].total })
+
+	expected := map[string]Group{
+		"eu": {Money{750, "EUR"}, 3},
+		"us": {Money{375, "USD"}, 2},
+	}
+
+	if err != nil || !reflect.DeepEqual(groups, expected) {
+		t.Errorf("Expected %v but got %v %v", expected, groups, err)
+	}
+
+	if groups, err := GroupBy([]int(nil), nil, nil); err != nil || len(groups) != 0 {
+		t.Errorf("Expected no groups but got %v %v", groups, err)
+	}
+}
+
+func TestGroupByWhenInvalid(t *testing.T) {
+	amounts := []Money{{100, "USD"}, {100, "EUR"}}
+	key := func(i int) string { return "all" }
+
+	if _, err := GroupBy(amounts, key, func(i int) Money { return amounts[i] }); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+
+	large := []Money{{math.MaxInt64, "USD"}, {1, "USD"}}
+
+	if _, err := GroupBy(large, key, func(i int) Money { return large[i] }); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow but got %v", err)
+	}
+
+	if _, err := GroupBy(map[string]Money{}, key, nil); err == nil {
+		t.Error("Expected a map to be rejected")
+	}
+}
@@ -0,0 +1,222 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MissingDatePolicy selects the rate HistoricalRates uses for a date without a
+// published one, such as a weekend or a holiday
+type MissingDatePolicy int
+
+const (
+	// ExactRate fails for dates without a published rate
+	ExactRate MissingDatePolicy = iota
+
+	// PreviousRate uses the rate of the closest earlier date
+	PreviousRate
+
+	// NextRate uses the rate of the closest later date
+	NextRate
+
+	// InterpolatedRate interpolates linearly, by day, between the rates of the
+	// closest earlier and later dates
+	InterpolatedRate
+)
+
+var missingDatePolicyNames = []string{"exact", "previous", "next", "interpolated"}
+
+// String returns the name of the policy, e.g. "previous"
+func (p MissingDatePolicy) String() string {
+	if p < 0 || int(p) >= len(missingDatePolicyNames) {
+		return fmt.Sprintf("MissingDatePolicy(%d)", int(p))
+	}
+
+	return missingDatePolicyNames[p]
+}
+
+// HistoricalRate is the rate of a pair on a date, with the policy it was
+// resolved by, ExactRate for a published one, and the dates of the published
+// rates it derives from
+type HistoricalRate struct {
+	Pair   Pair
+	Date   time.Time
+	Rate   float64
+	Policy MissingDatePolicy
+	From   time.Time
+	To     time.Time
+}
+
+// HistoricalConversion is the result of HistoricalRates.ConvertOn with the rate
+// it used
+type HistoricalConversion struct {
+	Result Money
+	Rate   HistoricalRate
+}
+
+// datedRate is a rate published on a date
+type datedRate struct {
+	date time.Time
+	rate float64
+}
+
+// HistoricalRates holds the rates published for pairs on dates, such as the
+// daily reference rates of a central bank. Dates are days: the time of day and
+// location of the times passed are ignored. It is safe for concurrent use.
+type HistoricalRates struct {
+	policy MissingDatePolicy
+	maxGap int
+
+	mu    sync.RWMutex
+	rates map[Pair][]datedRate
+}
+
+// NewHistoricalRates returns empty HistoricalRates resolving dates without a
+// published rate by policy, from published rates at most maxGap days away, or
+// any when maxGap is zero
+func NewHistoricalRates(policy MissingDatePolicy, maxGap int) (*HistoricalRates, error) {
+	if policy < ExactRate || policy > InterpolatedRate || maxGap < 0 {
+		return nil, fmt.Errorf("money: invalid missing date policy %s within %d days", policy, maxGap)
+	}
+
+	return &HistoricalRates{policy: policy, maxGap: maxGap, rates: map[Pair][]datedRate{}}, nil
+}
+
+// Set sets the rate published for pair on date, replacing any previous one
+func (h *HistoricalRates) Set(pair Pair, date time.Time, rate float64) error {
+	pair = Pair{strings.ToUpper(pair.Base), strings.ToUpper(pair.Quote)}
+
+	if err := pair.Validate(); err != nil {
+		return err
+	}
+
+	if err := checkCachedRate(pair, rate); err != nil {
+		return err
+	}
+
+	day := civilDay(date)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	series := h.rates[pair]
+	i := sort.Search(len(series), func(i int) bool { return !series[i].date.Before(day) })
+
+	if i < len(series) && series[i].date.Equal(day) {
+		series[i].rate = rate
+		return nil
+	}
+
+	series = append(series, datedRate{})
+	copy(series[i+1:], series[i:])
+	series[i] = datedRate{day, rate}
+	h.rates[pair] = series
+
+	return nil
+}
+
+// RateOn returns the rate of pair on date, from the rates set for the pair or
+// else for its inverse, resolving dates without a published rate by the policy.
+// It fails with ErrNoRate when the policy finds no rate.
+func (h *HistoricalRates) RateOn(pair Pair, date time.Time) (HistoricalRate, error) {
+	pair = Pair{strings.ToUpper(pair.Base), strings.ToUpper(pair.Quote)}
+
+	if err := pair.Validate(); err != nil {
+		return HistoricalRate{}, err
+	}
+
+	day := civilDay(date)
+
+	h.mu.RLock()
+	series, inverse := h.rates[pair], false
+
+	if series == nil {
+		series, inverse = h.rates[pair.Inverse()], true
+	}
+
+	h.mu.RUnlock()
+
+	r, ok := h.resolve(series, day)
+
+	if !ok {
+		return HistoricalRate{}, fmt.Errorf("%w for %s on %s by the %s rate", ErrNoRate, pair, day.Format("2006-01-02"), h.policy)
+	}
+
+	if inverse {
+		r.Rate = 1 / r.Rate
+	}
+
+	r.Pair = pair
+
+	return r, nil
+}
+
+// ConvertOn converts m into to at the rate of date, rounded half away from zero
+// to the minor unit of to as ConvertMoney does
+func (h *HistoricalRates) ConvertOn(m Money, to string, date time.Time) (HistoricalConversion, error) {
+	to = strings.ToUpper(to)
+
+	if _, ok := currencies[to]; ok && m.currency == to {
+		day := civilDay(date)
+		return HistoricalConversion{m, HistoricalRate{Pair{to, to}, day, 1, ExactRate, day, day}}, nil
+	}
+
+	r, err := h.RateOn(Pair{m.currency, to}, date)
+
+	if err != nil {
+		return HistoricalConversion{}, err
+	}
+
+	result, err := convertUnits(m, to, decimalRat(r.Rate))
+
+	if err != nil {
+		return HistoricalConversion{}, err
+	}
+
+	return HistoricalConversion{result, r}, nil
+}
+
+// resolve returns the rate of day in series by the policy
+func (h *HistoricalRates) resolve(series []datedRate, day time.Time) (HistoricalRate, bool) {
+	i := sort.Search(len(series), func(i int) bool { return !series[i].date.Before(day) })
+
+	if i < len(series) && series[i].date.Equal(day) {
+		return HistoricalRate{Date: day, Rate: series[i].rate, Policy: ExactRate, From: day, To: day}, true
+	}
+
+	// series[i-1] is the closest earlier rate and series[i] the closest later one
+	previous, next := i > 0 && h.within(series[i-1].date, day), i < len(series) && h.within(day, series[i].date)
+
+	switch {
+	case h.policy == PreviousRate && previous:
+		p := series[i-1]
+		return HistoricalRate{Date: day, Rate: p.rate, Policy: PreviousRate, From: p.date, To: p.date}, true
+	case h.policy == NextRate && next:
+		n := series[i]
+		return HistoricalRate{Date: day, Rate: n.rate, Policy: NextRate, From: n.date, To: n.date}, true
+	case h.policy == InterpolatedRate && previous && next:
+		p, n := series[i-1], series[i]
+		weight := day.Sub(p.date).Hours() / n.date.Sub(p.date).Hours()
+		rate := p.rate + (n.rate-p.rate)*weight
+
+		return HistoricalRate{Date: day, Rate: rate, Policy: InterpolatedRate, From: p.date, To: n.date}, true
+	}
+
+	return HistoricalRate{}, false
+}
+
+// within reports whether the days from earlier to later are at most maxGap
+func (h *HistoricalRates) within(earlier, later time.Time) bool {
+	return h.maxGap == 0 || math.Round(later.Sub(earlier).Hours()/24) <= float64(h.maxGap)
+}
+
+// civilDay returns the date of t in its location, at midnight UTC
+func civilDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,94 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestHistoricalRates(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 15, 0, 0, 0, time.UTC) }
+	friday, monday := day(6), day(9)
+
+	values := []struct {
+		policy   MissingDatePolicy
+		date     time.Time
+		rate     float64
+		from, to time.Time
+	}{
+		{ExactRate, friday, 1.08, friday, friday},
+		{PreviousRate, day(7), 1.08, friday, friday},
+		{NextRate, day(8), 1.11, monday, monday},
+		{InterpolatedRate, day(7), 1.09, friday, monday},
+		{InterpolatedRate, monday, 1.11, monday, monday},
+	}
+
+	for _, v := range values {
+		h, _ := NewHistoricalRates(v.policy, 0)
+		h.Set(Pair{"EUR", "USD"}, monday, 1.11)
+		h.Set(Pair{"eur", "usd"}, friday, 1.07)
+		h.Set(Pair{"EUR", "USD"}, friday, 1.08)
+
+		r, err := h.RateOn(Pair{"EUR", "USD"}, v.date)
+		expected := v.policy
+
+		if v.from.Equal(v.date) {
+			expected = ExactRate
+		}
+
+		if err != nil || math.Abs(r.Rate-v.rate) > 1e-12 || r.Policy != expected || !r.From.Equal(civilDay(v.from)) || !r.To.Equal(civilDay(v.to)) {
+			t.Errorf("Expected %v from %s to %s by %s but got %+v %v", v.rate, v.from, v.to, expected, r, err)
+		}
+	}
+}
+
+func TestHistoricalRatesConvertOn(t *testing.T) {
+	friday := time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC)
+	h, _ := NewHistoricalRates(PreviousRate, 3)
+	h.Set(Pair{"EUR", "USD"}, friday, 1.25)
+
+	c, err := h.ConvertOn(Money{1000, "USD"}, "EUR", friday.AddDate(0, 0, 2))
+
+	if err != nil || c.Result != (Money{800, "EUR"}) || c.Rate.Policy != PreviousRate || c.Rate.Pair != (Pair{"USD", "EUR"}) {
+		t.Errorf("Expected €8.00 by the previous inverse rate but got %+v %v", c, err)
+	}
+
+	if _, err := h.ConvertOn(Money{1000, "USD"}, "EUR", friday.AddDate(0, 0, 4)); !errors.Is(err, ErrNoRate) {
+		t.Errorf("Expected a rate beyond the gap to be rejected but got %v", err)
+	}
+
+	if _, err := h.ConvertOn(Money{1000, "USD"}, "EUR", friday.AddDate(0, 0, -1)); !errors.Is(err, ErrNoRate) {
+		t.Errorf("Expected ErrNoRate before the first rate but got %v", err)
+	}
+
+	if c, err := h.ConvertOn(Money{1000, "USD"}, "usd", friday); err != nil || c.Result != (Money{1000, "USD"}) {
+		t.Errorf("Expected the amount unchanged but got %+v %v", c, err)
+	}
+}
+
+func TestHistoricalRatesWhenInvalid(t *testing.T) {
+	if _, err := NewHistoricalRates(MissingDatePolicy(9), 0); err == nil {
+		t.Error("Expected an invalid policy to be rejected")
+	}
+
+	h, _ := NewHistoricalRates(ExactRate, 0)
+
+	if err := h.Set(Pair{"EUR", "USD"}, time.Now(), math.NaN()); err == nil {
+		t.Error("Expected an invalid rate to be rejected")
+	}
+
+	if err := h.Set(Pair{"EUR", "EUR"}, time.Now(), 1); err == nil {
+		t.Error("Expected an invalid pair to be rejected")
+	}
+
+	h.Set(Pair{"EUR", "USD"}, time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC), 1.08)
+
+	if _, err := h.RateOn(Pair{"EUR", "USD"}, time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC)); !errors.Is(err, ErrNoRate) {
+		t.Errorf("Expected ErrNoRate but got %v", err)
+	}
+
+	if s := MissingDatePolicy(9).String(); s != "MissingDatePolicy(9)" {
+		t.Errorf("Expected MissingDatePolicy(9) but got %s", s)
+	}
+}
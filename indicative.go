@@ -0,0 +1,101 @@
+package money
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// indicativeData is the snapshot of IndicativeRates, refreshed with releases
+//
+//go:embed indicative_rates.json
+var indicativeData []byte
+
+// IndicativeSnapshot is a snapshot of rates of major currencies, against a base
+// currency, published on a date
+type IndicativeSnapshot struct {
+	Date  time.Time
+	Base  string
+	rates map[string]float64
+}
+
+var indicative struct {
+	once     sync.Once
+	snapshot *IndicativeSnapshot
+	err      error
+}
+
+// IndicativeRates returns the snapshot of mid rates of major currencies built
+// into the package, refreshed with its releases. The rates are indicative only,
+// out of date by the age of the release: they let development environments and
+// air gapped deployments exercise conversions, and must not price or settle
+// anything. Use them as the provider of an Exchange, or as the last provider of
+// a Failover.
+func IndicativeRates() (*IndicativeSnapshot, error) {
+	indicative.once.Do(func() {
+		indicative.snapshot, indicative.err = parseIndicative(indicativeData)
+	})
+
+	return indicative.snapshot, indicative.err
+}
+
+// Rate returns the amount of to bought by one unit of from, derived through the
+// base currency, failing with ErrNoRate for currencies missing from the snapshot
+func (s *IndicativeSnapshot) Rate(from, to string) (float64, error) {
+	from, to = strings.ToUpper(from), strings.ToUpper(to)
+	in, ok := s.rates[from]
+	out, found := s.rates[to]
+
+	if !ok || !found {
+		return 0, fmt.Errorf("%w for %s/%s in the indicative rates of %s", ErrNoRate, from, to, s.Date.Format("2006-01-02"))
+	}
+
+	return out / in, nil
+}
+
+// Currencies returns the number of currencies of the snapshot, its base included
+func (s *IndicativeSnapshot) Currencies() int {
+	return len(s.rates)
+}
+
+// parseIndicative decodes a snapshot of rates against a base currency
+func parseIndicative(data []byte) (*IndicativeSnapshot, error) {
+	var raw struct {
+		Date  string             `json:"date"`
+		Base  string             `json:"base"`
+		Rates map[string]float64 `json:"rates"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("money: invalid indicative rates: %s", err)
+	}
+
+	date, err := time.Parse("2006-01-02", raw.Date)
+
+	if err != nil {
+		return nil, fmt.Errorf("money: invalid indicative rates date %q", raw.Date)
+	}
+
+	s := &IndicativeSnapshot{Date: date, Base: raw.Base, rates: map[string]float64{raw.Base: 1}}
+
+	for code, rate := range raw.Rates {
+		if _, ok := currencies[code]; !ok {
+			return nil, fmt.Errorf("money: indicative rates: %w %q", ErrUnknownCurrency, code)
+		}
+
+		if err := checkCachedRate(Pair{raw.Base, code}, rate); err != nil {
+			return nil, err
+		}
+
+		s.rates[code] = rate
+	}
+
+	if _, ok := currencies[raw.Base]; !ok {
+		return nil, fmt.Errorf("money: indicative rates: %w %q", ErrUnknownCurrency, raw.Base)
+	}
+
+	return s, nil
+}
@@ -0,0 +1,50 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestIndicativeRates(t *testing.T) {
+	s, err := IndicativeRates()
+
+	if err != nil || s.Base != "USD" || s.Date.IsZero() || s.Currencies() < 10 {
+		t.Fatalf("Expected the embedded snapshot but got %+v %v", s, err)
+	}
+
+	usd, _ := s.Rate("usd", "EUR")
+	eur, _ := s.Rate("EUR", "USD")
+
+	if math.Abs(usd*eur-1) > 1e-12 {
+		t.Errorf("Expected inverse rates but got %v and %v", usd, eur)
+	}
+
+	if rate, err := s.Rate("EUR", "EUR"); err != nil || rate != 1 {
+		t.Errorf("Expected 1 but got %v %v", rate, err)
+	}
+
+	if _, err := s.Rate("EUR", "XAU"); !errors.Is(err, ErrNoRate) {
+		t.Errorf("Expected ErrNoRate but got %v", err)
+	}
+
+	e, _ := NewExchange("USD", s)
+
+	if _, err := e.ConvertMoney(Money{10000, "GBP"}, "JPY"); err != nil {
+		t.Errorf("Expected the snapshot to convert but got %v", err)
+	}
+}
+
+func TestParseIndicativeWhenInvalid(t *testing.T) {
+	for _, data := range []string{
+		`{`,
+		`{"date": "yesterday", "base": "USD"}`,
+		`{"date": "2026-10-01", "base": "USD", "rates": {"XYZ": 1}}`,
+		`{"date": "2026-10-01", "base": "USD", "rates": {"EUR": 0}}`,
+		`{"date": "2026-10-01", "base": "XYZ", "rates": {}}`,
+	} {
+		if _, err := parseIndicative([]byte(data)); err == nil {
+			t.Errorf("Expected %s to be rejected", data)
+		}
+	}
+}
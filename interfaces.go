@@ -0,0 +1,46 @@
+package money
+
+// Formatter formats Money, as Template does. Applications can depend on it
+// rather than on a concrete type, e.g. to substitute a fake in tests.
+type Formatter interface {
+	Format(m Money) string
+}
+
+// FormatterFunc adapts a function to the Formatter interface
+type FormatterFunc func(m Money) string
+
+// Format calls f(m)
+func (f FormatterFunc) Format(m Money) string {
+	return f(m)
+}
+
+// Parser parses Money, as Parse and ParseCache do
+type Parser interface {
+	Parse(s string, opts ...Options) (Money, error)
+}
+
+// ParserFunc adapts a function, such as Parse, to the Parser interface
+type ParserFunc func(s string, opts ...Options) (Money, error)
+
+// Parse calls f(s, opts...)
+func (f ParserFunc) Parse(s string, opts ...Options) (Money, error) {
+	noteDeprecated(opts...)
+
+	return f(s, opts...)
+}
+
+// ConverterFunc adapts a function to the Converter interface
+type ConverterFunc func(m Money, to string) (Money, error)
+
+// ConvertMoney calls f(m, to)
+func (f ConverterFunc) ConvertMoney(m Money, to string) (Money, error) {
+	return f(m, to)
+}
+
+var (
+	_ Formatter = (*Template)(nil)
+	_ Formatter = (*RuleFormatter)(nil)
+	_ Parser    = (*ParseCache)(nil)
+	_ Parser    = ParserFunc(Parse)
+	_ Converter = (*Exchange)(nil)
+)
@@ -0,0 +1,34 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestInterfaces(t *testing.T) {
+	formatters := map[string]Formatter{
+		"template": NewTemplate(Options{"currency": "EUR"}),
+		"func":     FormatterFunc(func(m Money) string { return m.Format() }),
+	}
+
+	for name, f := range formatters {
+		if s := f.Format(Money{123456, "EUR"}); s != "€1.234,56" {
+			t.Errorf("Expected %s to format €1.234,56 but got %s", name, s)
+		}
+	}
+
+	parsers := map[string]Parser{"cache": NewParseCache(8), "func": ParserFunc(Parse)}
+
+	for name, p := range parsers {
+		if m, err := p.Parse("€1.234,56"); err != nil || m != (Money{123456, "EUR"}) {
+			t.Errorf("Expected %s to parse €1.234,56 but got %s (%v)", name, m, err)
+		}
+	}
+
+	var c Converter = ConverterFunc(func(m Money, to string) (Money, error) {
+		return Money{m.amount * 2, to}, nil
+	})
+
+	if m, err := c.ConvertMoney(Money{100, "EUR"}, "USD"); err != nil || m != (Money{200, "USD"}) {
+		t.Errorf("Expected $2.00 but got %s (%v)", m, err)
+	}
+}
@@ -0,0 +1,89 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Intl.NumberFormat separators of the en-US locale, which FormatIntl follows
+const (
+	intlThousandsSeparator = ","
+	intlDecimalMark        = "."
+)
+
+// FormatIntl returns a formatted price string matching JavaScript's
+// Intl.NumberFormat("en-US", {style: "currency"}) for the given options.
+//
+// Supported options use the Intl names:
+//
+//	Options{
+//	  "currency":        "USD",
+//	  "currencyDisplay": "symbol",   // "symbol", "narrowSymbol" or "code"
+//	  "currencySign":    "standard", // "standard" or "accounting"
+//	  "useGrouping":     true,
+//	}
+//
+// Separators always follow en-US and fraction digits follow the currency sub unit,
+// rounding half away from zero as Intl does. A currency code is followed by a
+// no-break space (U+00A0), as in Intl output. Both symbol displays use the
+// currency registry symbol, which for some currencies (e.g. CAD) is the narrow one.
+func FormatIntl(val float64, opts ...Options) (result string) {
+	defer guard(nil)
+
+	options := Options{
+		"currency":        "USD",
+		"currencyDisplay": "symbol",
+		"currencySign":    "standard",
+		"useGrouping":     true,
+	}
+
+	if len(opts) > 0 {
+		options = override(options, opts[0])
+	}
+
+	code := options["currency"].(string)
+	c := currencies[code]
+	warnInexactFloat(val, code)
+
+	digits := c.exponent()
+	integer, fractional := splitDecimal(roundHalfUp(strconv.FormatFloat(math.Abs(val), 'f', -1, 64), digits))
+
+	if options["useGrouping"].(bool) {
+		integer = separateThousands(integer, intlThousandsSeparator)
+	}
+
+	result = integer
+
+	if digits > 0 {
+		result = result + intlDecimalMark + fractional
+	}
+
+	if options["currencyDisplay"].(string) == "code" {
+		result = fmt.Sprintf("%s %s", code, result)
+	} else {
+		result = resolveSymbol(code, c) + result
+	}
+
+	if math.Signbit(val) {
+		if options["currencySign"].(string) == "accounting" {
+			result = fmt.Sprintf("(%s)", result)
+		} else {
+			result = "-" + result
+		}
+	}
+
+	return result
+}
+
+// intlLayout returns l with the separators FormatIntl uses, grouping only when
+// l groups
+func intlLayout(l layout) layout {
+	if l.separator != "" {
+		l.separator = intlThousandsSeparator
+	}
+
+	l.mark = intlDecimalMark
+
+	return l
+}
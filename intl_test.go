@@ -0,0 +1,36 @@
+package money
+
+import (
+	"testing"
+)
+
+// Expected values follow the en-US output of Intl.NumberFormat("en-US", {style: "currency", ...}),
+// which separates a currency code from the amount with a no-break space
+func TestFormatIntl(t *testing.T) {
+	values := []struct {
+		val      float64
+		options  Options
+		expected string
+	}{
+		{1234.56, Options{}, "$1,234.56"},
+		{-1234.56, Options{}, "-$1,234.56"},
+		{-1234.56, Options{"currencySign": "accounting"}, "($1,234.56)"},
+		{1234.56, Options{"currencySign": "accounting"}, "$1,234.56"},
+		{1234.56, Options{"currencyDisplay": "code"}, "USD 1,234.56"},
+		{-1234.56, Options{"currencyDisplay": "code"}, "-USD 1,234.56"},
+		{1234.56, Options{"useGrouping": false}, "$1234.56"},
+		{1234.5, Options{"currency": "EUR"}, "€1,234.50"},
+		{1234.5, Options{"currency": "JPY"}, "¥1,235"},
+		{1234.5, Options{"currency": "GBP"}, "£1,234.50"},
+		{1.005, Options{}, "$1.01"},
+		{0.999, Options{}, "$1.00"},
+		{999999.995, Options{}, "$1,000,000.00"},
+		{0, Options{}, "$0.00"},
+	}
+
+	for _, v := range values {
+		if result := FormatIntl(v.val, v.options); result != v.expected {
+			t.Errorf("Expected %s but got %s", v.expected, result)
+		}
+	}
+}
@@ -0,0 +1,398 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// InvoiceLine is an item billed on an invoice
+type InvoiceLine struct {
+	Description string
+	UnitPrice   Money
+	Quantity    int64
+
+	// VATRate, when not nil, replaces the jurisdiction VAT rate for the line,
+	// e.g. with a reduced or a zero rate
+	VATRate *float64
+}
+
+// InvoiceDiscount reduces the subtotal by a rate of it or by a fixed amount
+type InvoiceDiscount struct {
+	Description string
+	Rate        float64
+	Amount      Money
+}
+
+// InvoiceInput is what AssembleInvoice computes an Invoice from
+type InvoiceInput struct {
+	Lines     []InvoiceLine
+	Discounts []InvoiceDiscount
+
+	// Jurisdiction is the code of the registered Jurisdiction levying its VAT
+	// rate and cash rounding, whose options format the amounts. Prices include
+	// VAT when the jurisdiction requires it. Without one no VAT is levied.
+	Jurisdiction string
+
+	// Scope, when not nil, must accept the invoice currency. Its options format
+	// the amounts, under those of the jurisdiction, and its cash rounding replaces
+	// the jurisdiction one.
+	Scope *Scope
+
+	// Locale is the BCP 47 display locale. English locales use the separators
+	// of FormatIntl; the package has none for other languages, which keep the
+	// currency conventions. The total in words is only spelled out in English.
+	Locale string
+
+	// Options are the formatting options of the amounts, over all others
+	Options Options
+}
+
+// InvoiceAmount is an amount of an Invoice with its formatted display
+type InvoiceAmount struct {
+	Amount  Money
+	Display string
+}
+
+// InvoiceLineTotal is an InvoiceLine with its total
+type InvoiceLineTotal struct {
+	Description string
+	Quantity    int64
+	VATRate     float64
+	UnitPrice   InvoiceAmount
+	Total       InvoiceAmount
+}
+
+// InvoiceDiscountLine is a discount deducted from the subtotal
+type InvoiceDiscountLine struct {
+	Description string
+	Amount      InvoiceAmount
+}
+
+// InvoiceTaxLine is the VAT levied at one rate on the discounted lines with that
+// rate. Base is net of VAT even when prices include it.
+type InvoiceTaxLine struct {
+	Label string
+	Rate  float64
+	Base  InvoiceAmount
+	Tax   InvoiceAmount
+}
+
+// Invoice is a computed invoice, ready for a template or a PDF renderer. Net,
+// the taxes unless prices include them, and Rounding add up to Total.
+type Invoice struct {
+	Currency     string
+	Lines        []InvoiceLineTotal
+	Subtotal     InvoiceAmount
+	Discounts    []InvoiceDiscountLine
+	Net          InvoiceAmount
+	Taxes        []InvoiceTaxLine
+	Rounding     InvoiceAmount
+	Total        InvoiceAmount
+	TotalInWords string
+}
+
+// AssembleInvoice computes and formats the invoice of input. The lines add up
+// to the subtotal, and the discounts, each rounded half away from zero, are
+// deducted from it to give the net. The discounts are allocated to the VAT
+// rates in proportion to the lines they apply to, and the VAT of each rate is
+// computed once on its discounted lines, with Jurisdiction.DisplayPrice or,
+// when prices include VAT, Jurisdiction.NetPrice. The total is rounded with
+// Scope.Round or Jurisdiction.RoundCash. It fails for mixed currencies, a
+// currency other than that of the jurisdiction or not accepted by the scope,
+// negative quantities, prices, rates or discounts, and discounts exceeding the
+// subtotal.
+func AssembleInvoice(input InvoiceInput) (invoice Invoice, err error) {
+	defer guard(&err)
+	noteDeprecated(input.Options)
+
+	if len(input.Lines) == 0 {
+		return Invoice{}, fmt.Errorf("money: invoice requires a line")
+	}
+
+	if err := validate(input.Options); err != nil {
+		return Invoice{}, err
+	}
+
+	code := input.Lines[0].UnitPrice.currency
+
+	if _, ok := currencies[code]; !ok {
+		return Invoice{}, fmt.Errorf("%w %q", ErrUnknownCurrency, code)
+	}
+
+	j := Jurisdiction{Currency: code}
+	options := defaults()
+
+	if input.Scope != nil {
+		if err := input.Scope.check(code); err != nil {
+			return Invoice{}, err
+		}
+
+		options = input.Scope.Options()
+	}
+
+	if input.Jurisdiction != "" {
+		if j, err = ForJurisdiction(input.Jurisdiction); err != nil {
+			return Invoice{}, err
+		}
+
+		if err := (Money{0, code}).sameCurrency(Money{currency: j.Currency}); err != nil {
+			return Invoice{}, err
+		}
+	}
+
+	options = override(override(options, j.Options), input.Options)
+	options["currency"] = code
+	l := cachedLayout(options)
+
+	if input.Locale != "" && englishLocale(input.Locale) {
+		l = intlLayout(l)
+	}
+
+	display := func(m Money) InvoiceAmount {
+		if options["format"].(string) != "" {
+			return InvoiceAmount{m, m.Format(options)}
+		}
+
+		return InvoiceAmount{m, string(l.appendUnits(nil, m.amount))}
+	}
+
+	// groups holds the line totals by VAT rate, in the order the rates appear
+	var rates []float64
+	var groups []Money
+	subtotal := Money{0, code}
+
+	invoice.Currency = code
+
+	for _, line := range input.Lines {
+		if err := line.UnitPrice.sameCurrency(subtotal); err != nil {
+			return Invoice{}, err
+		}
+
+		if line.Quantity < 0 || line.UnitPrice.IsNegative() {
+			return Invoice{}, fmt.Errorf("money: invoice line %q has a negative quantity or price", line.Description)
+		}
+
+		rate := j.VATRate
+
+		if line.VATRate != nil {
+			rate = *line.VATRate
+		}
+
+		if !(rate >= 0) || math.IsInf(rate, 0) {
+			return Invoice{}, fmt.Errorf("money: invoice line %q has invalid VAT rate %v", line.Description, rate)
+		}
+
+		g := 0
+
+		for g < len(rates) && rates[g] != rate {
+			g++
+		}
+
+		if g == len(rates) {
+			rates, groups = append(rates, rate), append(groups, Money{0, code})
+		}
+
+		total, err := line.UnitPrice.Multiply(line.Quantity)
+
+		if err != nil {
+			return Invoice{}, err
+		}
+
+		if groups[g], err = groups[g].Add(total); err != nil {
+			return Invoice{}, err
+		}
+
+		if subtotal, err = subtotal.Add(total); err != nil {
+			return Invoice{}, err
+		}
+
+		invoice.Lines = append(invoice.Lines, InvoiceLineTotal{
+			Description: line.Description,
+			Quantity:    line.Quantity,
+			VATRate:     rate,
+			UnitPrice:   display(line.UnitPrice),
+			Total:       display(total),
+		})
+	}
+
+	invoice.Subtotal = display(subtotal)
+	net := subtotal
+
+	for _, d := range input.Discounts {
+		amount := d.Amount
+
+		switch {
+		case d.Rate != 0 && amount.currency != "":
+			return Invoice{}, fmt.Errorf("money: invoice discount %q has both a rate and an amount", d.Description)
+		case d.Rate < 0 || amount.IsNegative():
+			return Invoice{}, fmt.Errorf("money: invoice discount %q is negative", d.Description)
+		case amount.currency == "":
+			if amount, err = subtotal.mulRate(d.Rate); err != nil {
+				return Invoice{}, err
+			}
+		}
+
+		if err := amount.sameCurrency(subtotal); err != nil {
+			return Invoice{}, err
+		}
+
+		if amount.amount > net.amount {
+			return Invoice{}, fmt.Errorf("money: invoice discounts exceed the subtotal %s", subtotal)
+		}
+
+		net.amount -= amount.amount
+		invoice.Discounts = append(invoice.Discounts, InvoiceDiscountLine{d.Description, display(amount)})
+	}
+
+	invoice.Net = display(net)
+
+	if discounts := subtotal.amount - net.amount; discounts > 0 {
+		ratios := make([]int, len(groups))
+
+		for i, g := range groups {
+			ratios[i] = int(g.amount)
+		}
+
+		shares, err := Money{discounts, code}.Allocate(ratios...)
+
+		if err != nil {
+			return Invoice{}, err
+		}
+
+		for i := range groups {
+			groups[i].amount -= shares[i].amount
+		}
+	}
+
+	total := net
+
+	for i, rate := range rates {
+		if rate == 0 {
+			continue
+		}
+
+		levy := j
+		levy.VATRate = rate
+		levy.PricesIncludeVAT = true
+		base, gross := groups[i], groups[i]
+
+		if j.PricesIncludeVAT {
+			base, err = levy.NetPrice(gross)
+		} else {
+			gross, err = levy.DisplayPrice(base)
+		}
+
+		if err != nil {
+			return Invoice{}, err
+		}
+
+		tax := Money{gross.amount - base.amount, code}
+
+		if !j.PricesIncludeVAT {
+			if total, err = total.Add(tax); err != nil {
+				return Invoice{}, err
+			}
+		}
+
+		invoice.Taxes = append(invoice.Taxes, InvoiceTaxLine{"VAT " + percent(rate), rate, display(base), display(tax)})
+	}
+
+	var rounded Money
+
+	if input.Scope != nil {
+		rounded, err = input.Scope.Round(total)
+	} else {
+		rounded, err = j.RoundCash(total)
+	}
+
+	if err != nil {
+		return Invoice{}, err
+	}
+
+	invoice.Rounding = display(Money{rounded.amount - total.amount, code})
+	invoice.Total = display(rounded)
+	invoice.TotalInWords = amountInWords(rounded)
+
+	return invoice, nil
+}
+
+// percent formats rate as a percentage, e.g. "8.1%" for 0.081
+func percent(rate float64) string {
+	r := decimalRat(rate)
+	text := strings.TrimRight(r.Mul(r, big.NewRat(100, 1)).FloatString(16), "0")
+
+	return strings.TrimSuffix(text, ".") + "%"
+}
+
+// amountInWords spells out the major units of m in English, followed by the
+// minor units as a fraction and the currency code, e.g. "twelve and 34/100 USD",
+// or returns an empty string in minimal builds
+func amountInWords(m Money) string {
+	if smallWords == nil {
+		return ""
+	}
+
+	c := currencies[m.currency]
+	abs := absUnits(m.amount)
+	units := uint64(c.units())
+
+	words := integerWords(abs / units)
+
+	if m.amount < 0 {
+		words = "minus " + words
+	}
+
+	if digits := c.exponent(); digits > 0 {
+		fraction := appendFraction(nil, abs%units*pow10(digits)/units, digits)
+		words += fmt.Sprintf(" and %s/%d", fraction, pow10(digits))
+	}
+
+	return words + " " + m.currency
+}
+
+// integerWords spells out u in English with the short scale, e.g. "one thousand
+// two hundred thirty-four"
+func integerWords(u uint64) string {
+	if u == 0 {
+		return smallWords[0]
+	}
+
+	var groups []string
+
+	for scale := 0; u > 0; scale, u = scale+1, u/1000 {
+		if n := u % 1000; n > 0 {
+			group := hundredsWords(n)
+
+			if scaleWords[scale] != "" {
+				group += " " + scaleWords[scale]
+			}
+
+			groups = append([]string{group}, groups...)
+		}
+	}
+
+	return strings.Join(groups, " ")
+}
+
+// hundredsWords spells out 1 to 999 in English
+func hundredsWords(n uint64) string {
+	var words []string
+
+	if n >= 100 {
+		words = append(words, smallWords[n/100], "hundred")
+		n %= 100
+	}
+
+	switch {
+	case n >= 20 && n%10 != 0:
+		words = append(words, tensWords[n/10]+"-"+smallWords[n%10])
+	case n >= 20:
+		words = append(words, tensWords[n/10])
+	case n > 0:
+		words = append(words, smallWords[n])
+	}
+
+	return strings.Join(words, " ")
+}
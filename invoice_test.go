@@ -0,0 +1,161 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAssembleInvoice(t *testing.T) {
+	reduced, zero := 0.026, 0.0
+
+	invoice, err := AssembleInvoice(InvoiceInput{
+		Lines: []InvoiceLine{
+			{"Consulting", Money{12000, "CHF"}, 10, nil},
+			{"Books", Money{3333, "CHF"}, 3, &reduced},
+			{"Postage", Money{700, "CHF"}, 1, &zero},
+		},
+		Discounts: []InvoiceDiscount{
+			{Description: "Loyalty 10%", Rate: 0.1},
+			{Description: "Voucher", Amount: Money{1000, "CHF"}},
+		},
+		Jurisdiction: "CH",
+		Locale:       "en-CH",
+		Options:      Options{"with_currency": true},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values := []struct {
+		result   string
+		expected string
+	}{
+		{invoice.Lines[1].Total.Display, "Fr99.99 CHF"},
+		{invoice.Subtotal.Display, "Fr1,306.99 CHF"},
+		{invoice.Discounts[0].Amount.Display, "Fr130.70 CHF"},
+		{invoice.Net.Display, "Fr1,166.29 CHF"},
+		{invoice.Taxes[0].Label, "VAT 8.1%"},
+		{invoice.Taxes[0].Base.Display, "Fr990.57 CHF"},
+		{invoice.Taxes[0].Tax.Display, "Fr80.24 CHF"},
+		{invoice.Taxes[1].Label, "VAT 2.6%"},
+		{invoice.Taxes[1].Base.Display, "Fr86.97 CHF"},
+		{invoice.Taxes[1].Tax.Display, "Fr2.26 CHF"},
+		{invoice.Rounding.Display, "Fr0.01 CHF"},
+		{invoice.Total.Display, "Fr1,166.30 CHF"},
+		{invoice.TotalInWords, "one thousand one hundred sixty-six and 30/100 CHF"},
+	}
+
+	if !localeData {
+		values[len(values)-1].expected = ""
+	}
+
+	for _, v := range values {
+		if v.result != v.expected {
+			t.Errorf("Expected %s but got %s", v.expected, v.result)
+		}
+	}
+
+	if len(invoice.Taxes) != 2 {
+		t.Errorf("Expected no tax line for the zero rate but got %d lines", len(invoice.Taxes))
+	}
+}
+
+func TestAssembleInvoiceWithScopeAndLocale(t *testing.T) {
+	if _, err := ForJurisdiction("TEST-INVOICE"); err != nil {
+		if err := RegisterJurisdiction(Jurisdiction{Code: "TEST-INVOICE", Currency: "EUR", VATRate: 0.2}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s, _ := NewScope(ScopeConfig{Defaults: Options{"currency": "EUR"}, CashRounding: 5})
+	zero := 0.0
+	input := InvoiceInput{
+		Lines:        []InvoiceLine{{"Licence", Money{123456, "EUR"}, 1, nil}, {"Postage", Money{1000, "EUR"}, 1, &zero}},
+		Jurisdiction: "TEST-INVOICE",
+		Scope:        s,
+		Locale:       "en-IE",
+	}
+
+	values := map[string][3]string{
+		"en-IE": {"€246.91", "-€0.02", "€1,491.45"},
+		"de-DE": {"€246,91", "-€0,02", "€1.491,45"},
+	}
+
+	for locale, expected := range values {
+		input.Locale = locale
+		invoice, err := AssembleInvoice(input)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		result := [3]string{invoice.Taxes[0].Tax.Display, invoice.Rounding.Display, invoice.Total.Display}
+
+		if result != expected {
+			t.Errorf("Expected %v for %s but got %v", expected, locale, result)
+		}
+	}
+
+	input.Scope, _ = NewScope(ScopeConfig{Currencies: []string{"USD"}})
+
+	if _, err := AssembleInvoice(input); err == nil {
+		t.Errorf("Expected a currency the scope does not accept to fail")
+	}
+}
+
+func TestAssembleInvoiceWhenInvalid(t *testing.T) {
+	line := InvoiceLine{"Item", Money{1000, "USD"}, 1, nil}
+	negative := -0.1
+
+	inputs := []InvoiceInput{
+		{},
+		{Lines: []InvoiceLine{line}, Jurisdiction: "CH"},
+		{Lines: []InvoiceLine{line}, Jurisdiction: "XX"},
+		{Lines: []InvoiceLine{line, {"Other", Money{1000, "EUR"}, 1, nil}}},
+		{Lines: []InvoiceLine{{"Item", Money{1000, "USD"}, -1, nil}}},
+		{Lines: []InvoiceLine{{"Item", Money{1000, "USD"}, 1, &negative}}},
+		{Lines: []InvoiceLine{line}, Discounts: []InvoiceDiscount{{Amount: Money{1001, "USD"}}}},
+		{Lines: []InvoiceLine{line}, Discounts: []InvoiceDiscount{{Rate: 0.1, Amount: Money{100, "USD"}}}},
+		{Lines: []InvoiceLine{line}, Options: Options{"with_cents": "no"}},
+	}
+
+	for i, input := range inputs {
+		if _, err := AssembleInvoice(input); err == nil {
+			t.Errorf("Expected input %d to fail", i)
+		}
+	}
+
+	if _, err := AssembleInvoice(InvoiceInput{Lines: []InvoiceLine{{"Item", Money{1, "XYZ"}, 1, nil}}}); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+}
+
+func TestAmountInWords(t *testing.T) {
+	skipWithoutLocaleData(t)
+
+	values := []struct {
+		m        Money
+		expected string
+	}{
+		{Money{0, "USD"}, "zero and 00/100 USD"},
+		{Money{1234, "USD"}, "twelve and 34/100 USD"},
+		{Money{-100000001, "USD"}, "minus one million and 01/100 USD"},
+		{Money{1234567, "JPY"}, "one million two hundred thirty-four thousand five hundred sixty-seven JPY"},
+		{Money{20001500, "USD"}, "two hundred thousand fifteen and 00/100 USD"},
+	}
+
+	for _, v := range values {
+		if result := amountInWords(v.m); result != v.expected {
+			t.Errorf("Expected %s but got %s", v.expected, result)
+		}
+	}
+}
+
+func TestPercent(t *testing.T) {
+	for rate, expected := range map[float64]string{0.081: "8.1%", 0.2: "20%", 0: "0%", 0.0725: "7.25%"} {
+		if result := percent(rate); result != expected {
+			t.Errorf("Expected %s but got %s", expected, result)
+		}
+	}
+}
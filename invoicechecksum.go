@@ -0,0 +1,81 @@
+package money
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+)
+
+// crockfordBase32 is the alphabet of Crockford's Base32, without the letters I,
+// L, O and U easily misread when copying checksums by hand
+const crockfordBase32 = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// InvoiceChecksum returns a short checksum of the line amounts of an invoice and
+// their total, e.g. "7K2M-QX4D", to print in the footer so a tampered or
+// mistyped amount is detected by VerifyInvoiceChecksum. The amounts are
+// hashed in order with SHA-256, as the plain decimals ControlSum reads, and 40
+// bits of the hash are written in Crockford's Base32. It fails for mixed
+// currencies and a total overflowing.
+func InvoiceChecksum(lines []Money) (string, error) {
+	if len(lines) == 0 {
+		return "", fmt.Errorf("money: no invoice lines to checksum")
+	}
+
+	code := lines[0].currency
+	c, ok := currencies[code]
+
+	if !ok {
+		return "", fmt.Errorf("%w %q", ErrUnknownCurrency, code)
+	}
+
+	fields := make([]string, len(lines))
+
+	for i, line := range lines {
+		if err := lines[0].sameCurrency(line); err != nil {
+			return "", err
+		}
+
+		fields[i] = formatUnits(line.amount, c.exponent())
+	}
+
+	total, err := ControlSum(code, fields...)
+
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256([]byte(code + "\n" + strings.Join(fields, "\n") + "\ntotal " + total))
+
+	// the first 40 bits of the hash, five bits per character
+	var b strings.Builder
+	bits := uint64(sum[0])<<32 | uint64(sum[1])<<24 | uint64(sum[2])<<16 | uint64(sum[3])<<8 | uint64(sum[4])
+
+	for i := 7; i >= 0; i-- {
+		b.WriteByte(crockfordBase32[bits>>(5*i)&31])
+
+		if i == 4 {
+			b.WriteByte('-')
+		}
+	}
+
+	return b.String(), nil
+}
+
+// VerifyInvoiceChecksum checks checksum is the InvoiceChecksum of lines. As
+// Crockford's Base32 allows, the checksum may be in lower case, without its
+// hyphen, and with O for 0 and I or L for 1.
+func VerifyInvoiceChecksum(checksum string, lines []Money) error {
+	expected, err := InvoiceChecksum(lines)
+
+	if err != nil {
+		return err
+	}
+
+	normalized := strings.NewReplacer("-", "", "O", "0", "I", "1", "L", "1").Replace(strings.ToUpper(strings.TrimSpace(checksum)))
+
+	if normalized != strings.Replace(expected, "-", "", 1) {
+		return fmt.Errorf("money: invoice checksum %s does not match the lines", checksum)
+	}
+
+	return nil
+}
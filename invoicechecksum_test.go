@@ -0,0 +1,59 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestInvoiceChecksum(t *testing.T) {
+	lines := []Money{{1999, "USD"}, {450, "USD"}, {-200, "USD"}}
+	checksum, err := InvoiceChecksum(lines)
+
+	if err != nil || !regexp.MustCompile(`^[0-9A-HJKMNP-TV-Z]{4}-[0-9A-HJKMNP-TV-Z]{4}$`).MatchString(checksum) {
+		t.Fatalf("Expected a checksum of two groups of 4 but got %q %v", checksum, err)
+	}
+
+	if again, _ := InvoiceChecksum(lines); again != checksum {
+		t.Errorf("Expected a stable checksum but got %s and %s", checksum, again)
+	}
+
+	for _, typed := range []string{checksum, strings.ToLower(checksum), strings.Replace(checksum, "-", "", 1), " " + strings.NewReplacer("0", "O", "1", "I").Replace(checksum)} {
+		if err := VerifyInvoiceChecksum(typed, lines); err != nil {
+			t.Errorf("Expected %q to verify but got %v", typed, err)
+		}
+	}
+
+	tampered := [][]Money{
+		{{1999, "USD"}, {451, "USD"}, {-200, "USD"}},
+		{{450, "USD"}, {1999, "USD"}, {-200, "USD"}},
+		{{1999, "USD"}, {450, "USD"}},
+		{{1999, "EUR"}, {450, "EUR"}, {-200, "EUR"}},
+	}
+
+	for _, other := range tampered {
+		if err := VerifyInvoiceChecksum(checksum, other); err == nil {
+			t.Errorf("Expected %v to fail verification", other)
+		}
+	}
+}
+
+func TestInvoiceChecksumWhenInvalid(t *testing.T) {
+	if _, err := InvoiceChecksum(nil); err == nil {
+		t.Error("Expected no lines to be rejected")
+	}
+
+	if _, err := InvoiceChecksum([]Money{{1, "USD"}, {1, "EUR"}}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+
+	if _, err := InvoiceChecksum([]Money{{math.MaxInt64, "USD"}, {1, "USD"}}); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow but got %v", err)
+	}
+
+	if err := VerifyInvoiceChecksum("anything", []Money{{1, "XXX"}}); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+}
@@ -0,0 +1,208 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"sync"
+)
+
+// Jurisdiction bundles the price display and rounding rules of a legal
+// jurisdiction, e.g. Switzerland rounding cash amounts to 0.05 CHF
+type Jurisdiction struct {
+	// Code identifies the jurisdiction, e.g. the ISO 3166 country code "CH"
+	Code string
+
+	// Currency is the ISO code of the legal tender
+	Currency string
+
+	// CashRounding is the step in minor units cash amounts round to, 0 for none
+	CashRounding int64
+
+	// VATRate is the value added tax rate, e.g. 0.081 for 8.1%
+	VATRate float64
+
+	// PricesIncludeVAT requires displayed prices to include VAT
+	PricesIncludeVAT bool
+
+	// Options are the formatting options applied by Format
+	Options Options
+}
+
+var jurisdictions = struct {
+	sync.RWMutex
+	rules map[string]Jurisdiction
+}{rules: map[string]Jurisdiction{
+	"CH": {Code: "CH", Currency: "CHF", CashRounding: 5, VATRate: 0.081, PricesIncludeVAT: true},
+}}
+
+// RegisterJurisdiction registers the rules of a jurisdiction, selectable with
+// ForJurisdiction(j.Code)
+func RegisterJurisdiction(j Jurisdiction) error {
+	noteDeprecated(j.Options)
+
+	j.Code = strings.ToUpper(j.Code)
+	j.Currency = strings.ToUpper(j.Currency)
+
+	if j.Code == "" {
+		return fmt.Errorf("money: jurisdiction requires a code")
+	}
+
+	if _, ok := currencies[j.Currency]; !ok {
+		return fmt.Errorf("%w %q", ErrUnknownCurrency, j.Currency)
+	}
+
+	if j.CashRounding < 0 || !(j.VATRate >= 0) || math.IsInf(j.VATRate, 0) {
+		return fmt.Errorf("money: jurisdiction %s has invalid rounding or VAT rate", j.Code)
+	}
+
+	if err := validate(j.Options); err != nil {
+		return err
+	}
+
+	j.Options = override(Options{}, j.Options)
+
+	return unlessFrozen(func() error {
+		jurisdictions.Lock()
+		defer jurisdictions.Unlock()
+
+		if _, ok := jurisdictions.rules[j.Code]; ok {
+			return fmt.Errorf("money: jurisdiction %q is already registered", j.Code)
+		}
+
+		jurisdictions.rules[j.Code] = j
+
+		return nil
+	})
+}
+
+// ForJurisdiction returns the rules registered for the jurisdiction code
+func ForJurisdiction(code string) (Jurisdiction, error) {
+	jurisdictions.RLock()
+	defer jurisdictions.RUnlock()
+
+	j, ok := jurisdictions.rules[strings.ToUpper(code)]
+
+	if !ok {
+		return Jurisdiction{}, fmt.Errorf("money: unknown jurisdiction %q", code)
+	}
+
+	j.Options = override(Options{}, j.Options)
+
+	return j, nil
+}
+
+// TaxDisplay tells whether prices are displayed with or without tax
+type TaxDisplay int
+
+// Tax display conventions
+const (
+	TaxExclusive TaxDisplay = iota
+	TaxInclusive
+)
+
+// TaxDisplayDefault returns the tax display of the jurisdiction registered for
+// the country code
+func TaxDisplayDefault(country string) (TaxDisplay, error) {
+	j, err := ForJurisdiction(country)
+
+	if err != nil {
+		return TaxExclusive, err
+	}
+
+	if j.PricesIncludeVAT {
+		return TaxInclusive, nil
+	}
+
+	return TaxExclusive, nil
+}
+
+// RoundCash rounds m half away from zero to the cash rounding step
+func (j Jurisdiction) RoundCash(m Money) (Money, error) {
+	if err := m.sameCurrency(Money{currency: j.Currency}); err != nil {
+		return Money{}, err
+	}
+
+	if j.CashRounding <= 1 {
+		return m, nil
+	}
+
+	return roundToStep(m, j.CashRounding)
+}
+
+// DisplayPrice returns the price to display for a net amount, adding VAT when
+// the jurisdiction requires prices to include it
+func (j Jurisdiction) DisplayPrice(net Money) (Money, error) {
+	if err := net.sameCurrency(Money{currency: j.Currency}); err != nil {
+		return Money{}, err
+	}
+
+	if !j.PricesIncludeVAT || j.VATRate == 0 {
+		return net, nil
+	}
+
+	vat, err := net.mulRate(j.VATRate)
+
+	if err != nil {
+		return Money{}, err
+	}
+
+	return net.Add(vat)
+}
+
+// NetPrice returns the net amount of a price including VAT, rounded half away
+// from zero, undoing DisplayPrice
+func (j Jurisdiction) NetPrice(gross Money) (Money, error) {
+	if err := gross.sameCurrency(Money{currency: j.Currency}); err != nil {
+		return Money{}, err
+	}
+
+	if j.VATRate == 0 {
+		return gross, nil
+	}
+
+	rate := decimalRat(j.VATRate)
+	net := new(big.Rat).SetInt64(gross.amount)
+	net.Quo(net, rate.Add(rate, big.NewRat(1, 1)))
+
+	units, _ := roundRat(net)
+
+	return Money{units, gross.currency}, nil
+}
+
+// Format formats m with the jurisdiction options, overridden by opts
+func (j Jurisdiction) Format(m Money, opts ...Options) string {
+	options := override(Options{}, j.Options)
+
+	if len(opts) > 0 {
+		noteDeprecated(opts[0])
+		options = override(options, opts[0])
+	}
+
+	return m.Format(options)
+}
+
+// roundToStep rounds m half away from zero to a multiple of step minor units
+func roundToStep(m Money, step int64) (Money, error) {
+	remainder := m.amount % step
+	amount := m.amount - remainder
+
+	if 2*absUnits(remainder) >= uint64(step) {
+		if remainder > 0 {
+			if amount > math.MaxInt64-step {
+				return Money{}, fmt.Errorf("%w: %s", ErrOverflow, m)
+			}
+
+			amount += step
+		} else {
+			if amount < math.MinInt64+step {
+				return Money{}, fmt.Errorf("%w: %s", ErrOverflow, m)
+			}
+
+			amount -= step
+		}
+	}
+
+	return Money{amount, m.currency}, nil
+}
@@ -0,0 +1,135 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestForJurisdiction(t *testing.T) {
+	j, err := ForJurisdiction("ch")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if j.Currency != "CHF" || j.CashRounding != 5 {
+		t.Errorf("Expected CHF rounded to 0.05 but got %s %d", j.Currency, j.CashRounding)
+	}
+
+	if _, err := ForJurisdiction("XX"); err == nil {
+		t.Error("Expected unknown jurisdiction to fail")
+	}
+}
+
+func TestJurisdictionRoundCash(t *testing.T) {
+	j, _ := ForJurisdiction("CH")
+
+	values := map[int64]int64{
+		1002:  1000,
+		1003:  1005,
+		1007:  1005,
+		1008:  1010,
+		-1003: -1005,
+		-1002: -1000,
+	}
+
+	for amount, expected := range values {
+		m, err := j.RoundCash(Money{amount, "CHF"})
+
+		if err != nil || m.MinorUnits() != expected {
+			t.Errorf("Expected %d to round to %d but got %d %v", amount, expected, m.MinorUnits(), err)
+		}
+	}
+
+	if _, err := j.RoundCash(Money{1003, "EUR"}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+}
+
+func TestJurisdictionDisplayPrice(t *testing.T) {
+	j, _ := ForJurisdiction("CH")
+
+	m, err := j.DisplayPrice(Money{10000, "CHF"})
+
+	if err != nil || m != (Money{10810, "CHF"}) {
+		t.Errorf("Expected 108.10 CHF but got %d %v", m.MinorUnits(), err)
+	}
+}
+
+func TestJurisdictionNetPrice(t *testing.T) {
+	j, _ := ForJurisdiction("CH")
+
+	for _, gross := range []int64{10810, 10000, 1, -10810} {
+		net, err := j.NetPrice(Money{gross, "CHF"})
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if price, _ := j.DisplayPrice(net); absUnits(price.amount-gross) > 1 {
+			t.Errorf("Expected %d net of VAT to display as %d but got %d", net.amount, gross, price.amount)
+		}
+	}
+
+	if net, _ := j.NetPrice(Money{10810, "CHF"}); net != (Money{10000, "CHF"}) {
+		t.Errorf("Expected 100.00 CHF but got %s", net)
+	}
+
+	if _, err := j.NetPrice(Money{100, "EUR"}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+}
+
+func TestRegisterJurisdiction(t *testing.T) {
+	err := RegisterJurisdiction(Jurisdiction{Code: "test-de", Currency: "eur", VATRate: 0.19, PricesIncludeVAT: true, Options: Options{"with_currency": true}})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	j, _ := ForJurisdiction("TEST-DE")
+	price, _ := j.DisplayPrice(Money{1000, "EUR"})
+
+	if s := j.Format(price); s != "€11,90 EUR" {
+		t.Errorf("Expected €11,90 EUR but got %s", s)
+	}
+
+	if s := j.Format(price, Options{"with_currency": false}); s != "€11,90" {
+		t.Errorf("Expected €11,90 but got %s", s)
+	}
+
+	if err := RegisterJurisdiction(Jurisdiction{Code: "TEST-DE", Currency: "EUR"}); err == nil {
+		t.Error("Expected duplicate jurisdiction to fail")
+	}
+
+	invalid := []Jurisdiction{
+		{Currency: "EUR"},
+		{Code: "TEST-X", Currency: "XYZ"},
+		{Code: "TEST-X", Currency: "EUR", CashRounding: -5},
+		{Code: "TEST-X", Currency: "EUR", VATRate: math.NaN()},
+		{Code: "TEST-X", Currency: "EUR", Options: Options{"with_cents": "no"}},
+	}
+
+	for _, j := range invalid {
+		if err := RegisterJurisdiction(j); err == nil {
+			t.Errorf("Expected %+v to be rejected", j)
+		}
+	}
+}
+
+func TestTaxDisplayDefault(t *testing.T) {
+	RegisterJurisdiction(Jurisdiction{Code: "TEST-US", Currency: "USD"})
+
+	values := map[string]TaxDisplay{"ch": TaxInclusive, "TEST-US": TaxExclusive}
+
+	for country, expected := range values {
+		if display, err := TaxDisplayDefault(country); err != nil || display != expected {
+			t.Errorf("Expected %d for %s but got %d (%v)", expected, country, display, err)
+		}
+	}
+
+	if _, err := TaxDisplayDefault("TEST-NONE"); err == nil {
+		t.Error("Expected an unknown jurisdiction to fail")
+	}
+}
@@ -0,0 +1,74 @@
+package money
+
+import (
+	"fmt"
+)
+
+// LateFeePolicy describes the fees charged on an overdue amount. Zero values
+// disable a component: no grace period, no flat fee, no interest or no cap.
+type LateFeePolicy struct {
+	GraceDays int
+	Flat      Money
+	DailyRate float64
+	Cap       Money
+}
+
+// LateFeeBreakdown details the fee charged on an overdue amount
+type LateFeeBreakdown struct {
+	ChargeableDays int
+	Flat           Money
+	Interest       Money
+	Capped         bool
+	Total          Money
+}
+
+// LateFee returns the fee owed on outstanding after daysLate days. Once the grace
+// period is over the flat fee applies, and daily interest accrues on outstanding
+// for each day past the grace period; the total is limited to the cap.
+func LateFee(outstanding Money, daysLate int, policy LateFeePolicy) (LateFeeBreakdown, error) {
+	zero := Money{0, outstanding.currency}
+	fee := LateFeeBreakdown{Flat: zero, Interest: zero, Total: zero}
+
+	for _, m := range []Money{policy.Flat, policy.Cap} {
+		if m.currency != "" {
+			if err := outstanding.sameCurrency(m); err != nil {
+				return LateFeeBreakdown{}, err
+			}
+		}
+	}
+
+	if policy.GraceDays < 0 || policy.DailyRate < 0 || policy.Flat.IsNegative() || policy.Cap.IsNegative() {
+		return LateFeeBreakdown{}, fmt.Errorf("money: late fee policy values must not be negative")
+	}
+
+	if daysLate <= policy.GraceDays || !outstanding.IsPositive() {
+		return fee, nil
+	}
+
+	fee.ChargeableDays = daysLate - policy.GraceDays
+
+	if !policy.Flat.IsZero() {
+		fee.Flat = policy.Flat
+	}
+
+	accrued, err := outstanding.Multiply(int64(fee.ChargeableDays))
+
+	if err != nil {
+		return LateFeeBreakdown{}, err
+	}
+
+	if fee.Interest, err = accrued.mulRate(policy.DailyRate); err != nil {
+		return LateFeeBreakdown{}, err
+	}
+
+	if fee.Total, err = fee.Flat.Add(fee.Interest); err != nil {
+		return LateFeeBreakdown{}, err
+	}
+
+	if !policy.Cap.IsZero() && fee.Total.amount > policy.Cap.amount {
+		fee.Total = policy.Cap
+		fee.Capped = true
+	}
+
+	return fee, nil
+}
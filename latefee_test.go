@@ -0,0 +1,59 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLateFee(t *testing.T) {
+	policy := LateFeePolicy{
+		GraceDays: 5,
+		Flat:      Money{1000, "USD"},
+		DailyRate: 0.0005,
+		Cap:       Money{5000, "USD"},
+	}
+
+	fee, err := LateFee(Money{100000, "USD"}, 35, policy)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := LateFeeBreakdown{30, Money{1000, "USD"}, Money{1500, "USD"}, false, Money{2500, "USD"}}
+
+	if fee != expected {
+		t.Errorf("Expected %+v but got %+v", expected, fee)
+	}
+}
+
+func TestLateFeeWhenCapped(t *testing.T) {
+	policy := LateFeePolicy{Flat: Money{1000, "USD"}, DailyRate: 0.001, Cap: Money{5000, "USD"}}
+
+	fee, _ := LateFee(Money{100000, "USD"}, 60, policy)
+
+	if !fee.Capped || fee.Total != (Money{5000, "USD"}) || fee.Interest != (Money{6000, "USD"}) {
+		t.Errorf("Expected fee to be capped at $50.00 but got %+v", fee)
+	}
+}
+
+func TestLateFeeWhenInGracePeriod(t *testing.T) {
+	fee, err := LateFee(Money{100000, "USD"}, 5, LateFeePolicy{GraceDays: 5, Flat: Money{1000, "USD"}})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !fee.Total.IsZero() || fee.ChargeableDays != 0 || fee.Total.Currency() != "USD" {
+		t.Errorf("Expected no fee but got %+v", fee)
+	}
+}
+
+func TestLateFeeWhenInvalid(t *testing.T) {
+	if _, err := LateFee(Money{100, "USD"}, 10, LateFeePolicy{Flat: Money{100, "EUR"}}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+
+	if _, err := LateFee(Money{100, "USD"}, 10, LateFeePolicy{DailyRate: -0.1}); err == nil {
+		t.Error("Expected negative rate to be rejected")
+	}
+}
@@ -0,0 +1,105 @@
+package money
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Locale is a BCP 47 language tag the package has formatting data for
+type Locale string
+
+const (
+	// English is the phrasing of ApproximateForDisplay and the words of invoices
+	English Locale = "en"
+
+	// AmericanEnglish adds the Intl.NumberFormat separators of FormatIntl
+	AmericanEnglish Locale = "en-US"
+)
+
+// Locales returns the locales the package has formatting data for
+func Locales() []Locale {
+	return append([]Locale(nil), locales...)
+}
+
+// MatchLocale picks the locale best matching an Accept-Language header, such as
+// "de-CH, fr;q=0.9, en;q=0.8", with the BCP 47 lookup of RFC 4647: ranges are
+// tried by decreasing quality, each shortened a subtag at a time until it names
+// an available locale. It returns English when no range matches.
+func MatchLocale(acceptLanguage string) Locale {
+	for _, r := range languageRanges(acceptLanguage) {
+		for tag := r; tag != ""; tag = truncateTag(tag) {
+			for _, l := range locales {
+				if strings.EqualFold(string(l), tag) {
+					return l
+				}
+			}
+		}
+	}
+
+	return English
+}
+
+// languageRanges returns the ranges of an Accept-Language header by decreasing
+// quality, keeping the header order for equal ones and leaving out those with
+// a quality of zero or an invalid one
+func languageRanges(header string) []string {
+	type weighted struct {
+		tag     string
+		quality float64
+	}
+
+	var ranges []weighted
+
+	for _, field := range strings.Split(header, ",") {
+		params := strings.Split(field, ";")
+		tag := strings.Replace(strings.TrimSpace(params[0]), "_", "-", -1)
+		quality := 1.0
+
+		for _, p := range params[1:] {
+			if k, v, ok := strings.Cut(strings.TrimSpace(p), "="); ok && strings.EqualFold(k, "q") {
+				q, err := strconv.ParseFloat(v, 64)
+
+				if err != nil || q < 0 || q > 1 {
+					quality = 0
+				} else {
+					quality = q
+				}
+			}
+		}
+
+		if tag == "" || tag == "*" || quality == 0 {
+			continue
+		}
+
+		ranges = append(ranges, weighted{tag, quality})
+	}
+
+	sort.SliceStable(ranges, func(i, j int) bool { return ranges[i].quality > ranges[j].quality })
+
+	tags := make([]string, len(ranges))
+
+	for i, r := range ranges {
+		tags[i] = r.tag
+	}
+
+	return tags
+}
+
+// truncateTag removes the last subtag of tag, and a single letter subtag such as
+// an extension singleton left at its end
+func truncateTag(tag string) string {
+	i := strings.LastIndexByte(tag, '-')
+
+	if i < 0 {
+		return ""
+	}
+
+	tag = tag[:i]
+
+	if j := strings.LastIndexByte(tag, '-'); j >= 0 && len(tag)-j == 2 {
+		tag = tag[:j]
+	}
+
+	return tag
+}
@@ -0,0 +1,55 @@
+package money
+
+import (
+	"reflect"
+	"testing"
+)
+
+// skipWithoutLocaleData skips tests of the data left out by money_minimal
+func skipWithoutLocaleData(t *testing.T) {
+	if !localeData {
+		t.Skip("the money_minimal build has no locale data")
+	}
+}
+
+func TestMatchLocale(t *testing.T) {
+	skipWithoutLocaleData(t)
+
+	values := map[string]Locale{
+		"en-US,en;q=0.9":                AmericanEnglish,
+		"en-us":                         AmericanEnglish,
+		"en_US":                         AmericanEnglish,
+		"en-GB-oxendict":                English,
+		"en-US-x-twain":                 AmericanEnglish,
+		"de-CH, fr;q=0.9, en-US;q=0.8":  AmericanEnglish,
+		"de, en;q=0.5, en-US;q=0.4":     English,
+		"fr;q=0.7, en-US;q=0.8, en;q=1": English,
+		"en-US;q=0, en":                 English,
+		"en-US;q=abc":                   English,
+		"de-DE":                         English,
+		"*":                             English,
+		"":                              English,
+	}
+
+	for header, expected := range values {
+		if l := MatchLocale(header); l != expected {
+			t.Errorf("Expected %q to match %s but got %s", header, expected, l)
+		}
+	}
+}
+
+func TestLocales(t *testing.T) {
+	skipWithoutLocaleData(t)
+
+	l := Locales()
+
+	if !reflect.DeepEqual(l, []Locale{English, AmericanEnglish}) {
+		t.Errorf("Expected en and en-US but got %v", l)
+	}
+
+	l[0] = "fr"
+
+	if Locales()[0] != English {
+		t.Error("Expected Locales to return a copy")
+	}
+}
@@ -0,0 +1,23 @@
+//go:build !money_minimal
+
+package money
+
+// localeData reports whether the build includes the locale and compact notation
+// data left out by the money_minimal build tag
+const localeData = true
+
+// locales are the locales with formatting data, the default first
+var locales = []Locale{English, AmericanEnglish}
+
+// compactScales are the short scale suffixes by power of a thousand
+var compactScales = []string{"", "K", "M", "B", "T"}
+
+// English words of amountInWords
+var (
+	smallWords = []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten",
+		"eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen",
+	}
+	tensWords  = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	scaleWords = []string{"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion"}
+)
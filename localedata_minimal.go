@@ -0,0 +1,17 @@
+//go:build money_minimal
+
+package money
+
+// localeData reports whether the build includes the locale and compact notation
+// data left out by the money_minimal build tag
+const localeData = false
+
+// locales is empty: MatchLocale always returns English
+var locales []Locale
+
+// compactScales holds no suffixes, so compact amounts are formatted in full
+var compactScales = []string{""}
+
+// smallWords, tensWords and scaleWords are empty, so invoices carry no total
+// in words
+var smallWords, tensWords, scaleWords []string
@@ -0,0 +1,21 @@
+//go:build money_minimal
+
+package money
+
+import (
+	"testing"
+)
+
+func TestMinimalBuild(t *testing.T) {
+	if l := MatchLocale("en-US"); l != English || len(Locales()) != 0 {
+		t.Errorf("Expected no locales but got %s %v", l, Locales())
+	}
+
+	if s := cachedLayout(override(defaults(), Options{})).appendCompact(nil, 123456789, false); string(s) != "$1,234,567.89" {
+		t.Errorf("Expected the amount in full but got %s", s)
+	}
+
+	if s := amountInWords(Money{1234, "USD"}); s != "" {
+		t.Errorf("Expected no words but got %s", s)
+	}
+}
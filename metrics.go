@@ -0,0 +1,96 @@
+package money
+
+import (
+	"sync"
+	"time"
+)
+
+// Metrics receives measurements of the package for monitoring, e.g. as
+// Prometheus counters or OpenTelemetry spans. Its methods are called
+// synchronously and concurrently, so they must be fast and safe for concurrent
+// use. Embed NoMetrics to implement only some of them.
+type Metrics interface {
+	// RateFetched reports a call to the RateProvider of an Exchange
+	RateFetched(pair Pair, latency time.Duration, err error)
+
+	// CacheLookup reports a lookup in a cache, "parse" for a ParseCache
+	CacheLookup(cache string, hit bool)
+
+	// Converted reports a conversion of an Exchange
+	Converted(pair Pair, err error)
+
+	// Parsed reports the parsing of an amount, by Parse, ParseWithCurrency, a
+	// ParseCache missing it or a Scanner
+	Parsed(latency time.Duration, err error)
+}
+
+// NoMetrics ignores all measurements
+type NoMetrics struct{}
+
+// RateFetched does nothing
+func (NoMetrics) RateFetched(Pair, time.Duration, error) {}
+
+// CacheLookup does nothing
+func (NoMetrics) CacheLookup(string, bool) {}
+
+// Converted does nothing
+func (NoMetrics) Converted(Pair, error) {}
+
+// Parsed does nothing
+func (NoMetrics) Parsed(time.Duration, error) {}
+
+var metrics struct {
+	sync.RWMutex
+	m Metrics
+}
+
+// SetMetrics installs m to receive the measurements of the package. Passing nil
+// stops them.
+func SetMetrics(m Metrics) error {
+	return unlessFrozen(func() error {
+		metrics.Lock()
+		defer metrics.Unlock()
+
+		metrics.m = m
+
+		return nil
+	})
+}
+
+// currentMetrics returns the installed Metrics, or nil
+func currentMetrics() Metrics {
+	metrics.RLock()
+	defer metrics.RUnlock()
+
+	return metrics.m
+}
+
+// parseObserved parses s as parse does with suggestions, reporting it to the
+// installed Metrics
+func parseObserved(s, hint string, strict bool) (Money, error) {
+	mt := currentMetrics()
+
+	if mt == nil {
+		return parse(s, hint, strict, true)
+	}
+
+	start := time.Now()
+	m, err := parse(s, hint, strict, true)
+	mt.Parsed(time.Since(start), err)
+
+	return m, err
+}
+
+// observeCacheLookup reports a cache lookup to the installed Metrics
+func observeCacheLookup(cache string, hit bool) {
+	if mt := currentMetrics(); mt != nil {
+		mt.CacheLookup(cache, hit)
+	}
+}
+
+// observeConversion reports a conversion to the installed Metrics
+func observeConversion(pair Pair, err error) {
+	if mt := currentMetrics(); mt != nil {
+		mt.Converted(pair, err)
+	}
+}
@@ -0,0 +1,103 @@
+package money
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordedMetrics counts the measurements it receives
+type recordedMetrics struct {
+	NoMetrics
+	mu          sync.Mutex
+	fetched     []Pair
+	hits        int
+	misses      int
+	conversions map[Pair]int
+	failures    int
+	parsed      int
+}
+
+func (r *recordedMetrics) RateFetched(pair Pair, latency time.Duration, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.fetched = append(r.fetched, pair)
+}
+
+func (r *recordedMetrics) CacheLookup(cache string, hit bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if hit {
+		r.hits++
+	} else {
+		r.misses++
+	}
+}
+
+func (r *recordedMetrics) Converted(pair Pair, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.conversions[pair]++
+
+	if err != nil {
+		r.failures++
+	}
+}
+
+func (r *recordedMetrics) Parsed(latency time.Duration, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.parsed++
+}
+
+func TestSetMetrics(t *testing.T) {
+	r := &recordedMetrics{conversions: map[Pair]int{}}
+
+	if err := SetMetrics(r); err != nil {
+		t.Fatal(err)
+	}
+
+	defer SetMetrics(nil)
+
+	e, _ := NewExchange("USD", RateProviderFunc(func(from, to string) (float64, error) {
+		if to == "GBP" {
+			return 0, errors.New("feed down")
+		}
+
+		return 0.8, nil
+	}))
+
+	e.ConvertMoney(Money{100, "USD"}, "eur")
+	e.ConvertMoney(Money{100, "USD"}, "GBP")
+	e.ConvertWithTolerance(Money{100, "USD"}, "EUR", 0.8, 10)
+
+	c := NewParseCache(4)
+	c.Parse("$1.00")
+	c.Parse("$1.00")
+	Parse("$2.00")
+	ParseWithCurrency("3.00", "USD")
+
+	if len(r.fetched) != 3 || r.fetched[1] != (Pair{"USD", "GBP"}) {
+		t.Errorf("Expected 3 rate fetches but got %v", r.fetched)
+	}
+
+	if r.conversions[Pair{"USD", "EUR"}] != 2 || r.conversions[Pair{"USD", "GBP"}] != 1 || r.failures != 1 {
+		t.Errorf("Expected 3 conversions, one failed, but got %v and %d failures", r.conversions, r.failures)
+	}
+
+	if r.hits != 1 || r.misses != 1 || r.parsed != 3 {
+		t.Errorf("Expected a hit, a miss and 3 parses but got %d, %d and %d", r.hits, r.misses, r.parsed)
+	}
+
+	SetMetrics(nil)
+	Parse("$2.00")
+
+	if r.parsed != 3 {
+		t.Errorf("Expected the metrics to be removed but got %d parses", r.parsed)
+	}
+}
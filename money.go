@@ -6,6 +6,7 @@ Defaults
 
     Options{
       "currency":                 "USD",
+      "format":                   "",
       "with_cents":               true,
       "with_currency":            false,
       "with_symbol":              true,
@@ -23,46 +24,152 @@ Usage
     Format(10, Options{"with_symbol_space":true})            // "$ 10.00"
     Format(1000)                                             // "$1,000.00"
     Format(1000, Options{"with_thousands_separator": false}) // "$1000.00"
+
+Named formats registered with RegisterFormat or RegisterMoneyFormat are selected with the "format" option.
+
+Money values
+
+Money holds an exact amount in minor units, for arithmetic that never loses pennies.
+
+    m, _ := FromMinorUnits(1000, "usd")      // $10.00
+    total, _ := m.Multiply(3)                // $30.00
+    parts, _ := m.Split(3)                   // $3.34, $3.33, $3.33
+    total.Format(Options{"with_currency": true}) // "$30.00 USD"
+
+Hot paths compile options once with NewTemplate; Template.AppendFormat then
+formats Money in the template currency without allocating.
+
+Minimal builds
+
+The money_minimal build tag leaves out the locale data: MatchLocale finds no
+locale, compact amounts are formatted in full and invoices have no total in
+words. The currency registry, with the ISO codes, exponents and symbols Format
+needs, is kept.
 */
 package money
 
 import (
 	"fmt"
 	"math"
+	"strconv"
 	"strings"
 )
 
-// Format returns a formatted price string according to currency rules and options
+// Format returns a formatted price string according to currency rules and options,
+// val being rounded to the minor units of the currency
 func Format(val float64, opts ...Options) (result string) {
+	defer guard(nil)
+
 	options := defaults()
 
 	if len(opts) > 0 {
+		noteDeprecated(opts[0])
 		options = override(options, opts[0])
 	}
 
-	c := currencies[options["currency"].(string)]
+	warnInexactFloat(val, options["currency"].(string))
 
-	integer, fractional := splitValue(val)
+	return formatFloat(val, options)
+}
 
-	if options["with_thousands_separator"].(bool) {
-		result = separateThousands(integer, c.ThousandsSeparator)
-	} else {
-		result = integer
+// formatFloat formats val with options already merged with the defaults
+func formatFloat(val float64, options Options) string {
+	if fn, ok := lookupFormat(options["format"].(string)); ok {
+		options["format"] = ""
+		return fn(val, options)
 	}
 
-	if options["with_cents"].(bool) && c.SubUnit != "" {
-		result = fmt.Sprintf("%s%s%s", result, c.DecimalMark, fractional)
+	integer, fractional := splitValue(math.Abs(val), currencies[options["currency"].(string)])
+
+	return format(integer, fractional, val < 0, options)
+}
+
+// format renders integer and fractional digits of an amount according to
+// currency rules and options already merged with the defaults
+func format(integer, fractional string, negative bool, options Options) string {
+	return string(cachedLayout(options).appendDigits(nil, integer, fractional, negative))
+}
+
+// layout is the rendering of amounts resolved from options merged with the
+// defaults, so formatting many amounts interprets the options once
+type layout struct {
+	code      string
+	prefix    string
+	suffix    string
+	separator string
+	mark      string
+	cents     bool
+	units     uint64
+	digits    int
+}
+
+func newLayout(options Options) layout {
+	code := options["currency"].(string)
+	c := currencies[code]
+	l := layout{units: uint64(c.units()), digits: c.exponent(), mark: c.DecimalMark}
+
+	if options["with_thousands_separator"].(bool) {
+		l.separator = c.ThousandsSeparator
 	}
 
+	l.cents = options["with_cents"].(bool) && c.SubUnit != ""
+
 	if options["with_symbol"].(bool) {
-		result = addSymbol(result, c, options)
+		c.Symbol = resolveSymbol(code, c)
+
+		if affix := addSymbol("", c, options); c.SymbolFirst {
+			l.prefix = affix
+		} else {
+			l.suffix = affix
+		}
 	}
 
 	if options["with_currency"].(bool) {
-		result = fmt.Sprintf("%s %s", result, options["currency"].(string))
+		l.code = " " + code
 	}
 
-	return result
+	return l
+}
+
+// appendDigits appends the amount made of integer and fractional digits
+func (l layout) appendDigits(dst []byte, integer, fractional string, negative bool) []byte {
+	if negative && strings.Trim(integer+fractional, "0") != "" {
+		dst = append(dst, '-')
+	}
+
+	dst = append(dst, l.prefix...)
+	dst = appendGroupedDigits(dst, []byte(integer), l.separator)
+
+	if l.cents && fractional != "" {
+		dst = append(dst, l.mark...)
+		dst = append(dst, fractional...)
+	}
+
+	return l.appendSuffix(dst)
+}
+
+// appendUnits appends the amount given in minor units of the layout currency
+func (l layout) appendUnits(dst []byte, amount int64) []byte {
+	if amount < 0 {
+		dst = append(dst, '-')
+	}
+
+	abs := absUnits(amount)
+
+	dst = append(dst, l.prefix...)
+	dst = appendGrouped(dst, abs/l.units, l.separator)
+
+	if l.cents && l.digits > 0 {
+		dst = append(dst, l.mark...)
+		dst = appendFraction(dst, abs%l.units*pow10(l.digits)/l.units, l.digits)
+	}
+
+	return l.appendSuffix(dst)
+}
+
+func (l layout) appendSuffix(dst []byte) []byte {
+	dst = append(dst, l.suffix...)
+	return append(dst, l.code...)
 }
 
 func addSymbol(result string, c currency, options Options) string {
@@ -82,37 +189,18 @@ func addSymbol(result string, c currency, options Options) string {
 }
 
 func separateThousands(value, separator string) string {
-	chunks := len(value) / 3
-
-	if chunks == 0 {
-		return value
-	}
-
-	if partial := math.Mod(float64(len(value)), 3); partial > 0 {
-		chunks++
-	}
-
-	result := make([]string, chunks)
-
-	for i := chunks - 1; i >= 0; i-- {
-		if i == 0 {
-			result[i] = value
-			break
-		}
-
-		chunk := value[len(value)-3:]
-		value = strings.TrimSuffix(value, chunk)
-		result[i] = chunk
-	}
-
-	return strings.Join(result, separator)
+	return string(appendGroupedDigits(nil, []byte(value), separator))
 }
 
-func splitValue(val float64) (integer, fractional string) {
-	i, f := math.Modf(val)
+// splitValue splits val rounded to the fraction digits of the currency, snapped
+// to its minor units when they are not a power of ten, so that Parse reads the
+// formatted amount back
+func splitValue(val float64, c currency) (integer, fractional string) {
+	digits := c.exponent()
 
-	integer = fmt.Sprintf("%.0f", i)
-	fractional = fmt.Sprintf("%.2f", f)[2:]
+	if units := c.units(); units != int64(pow10(digits)) {
+		val = math.Round(val*float64(units)) / float64(units)
+	}
 
-	return
+	return splitDecimal(strconv.FormatFloat(val, 'f', digits, 64))
 }
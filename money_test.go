@@ -126,3 +126,24 @@ func TestSeparateThousands(t *testing.T) {
 		}
 	}
 }
+
+func TestFormatWhenNegative(t *testing.T) {
+	values := map[float64]string{
+		-10:      "-$10.00",
+		-1234.5:  "-$1,234.50",
+		-123:     "-$123.00",
+		-0.001:   "$0.00",
+		10.999:   "$11.00",
+		999.9999: "$1,000.00",
+	}
+
+	for value, expected := range values {
+		if currency := Format(value); currency != expected {
+			t.Errorf("Expected %s but got %s", expected, currency)
+		}
+	}
+
+	if currency := Format(-10, Options{"currency": "AFN"}); currency != "-10.00؋" {
+		t.Errorf("Expected -10.00؋ but got %s", currency)
+	}
+}
@@ -4,8 +4,23 @@ package money
 type Options map[string]interface{}
 
 func defaults() Options {
+	options := builtins()
+
+	settings.RLock()
+	defer settings.RUnlock()
+
+	for k, v := range settings.options {
+		options[k] = v
+	}
+
+	return options
+}
+
+// builtins returns the package default options, ignoring SetDefaults overrides
+func builtins() Options {
 	return Options{
 		"currency":                 "USD",
+		"format":                   "",
 		"with_cents":               true,
 		"with_currency":            false,
 		"with_symbol":              true,
@@ -15,8 +30,10 @@ func defaults() Options {
 }
 
 func override(original, override Options) Options {
+	strict := !panicFree.enabled.Load()
+
 	for k, v := range override {
-		if override[k] != nil {
+		if override[k] != nil && (strict || validOption(k, v)) {
 			original[k] = v
 		}
 	}
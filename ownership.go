@@ -0,0 +1,131 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"sort"
+)
+
+// ResidualPolicy selects who gets the minor units left when an amount is shared
+// by ownership and every share is rounded down
+type ResidualPolicy int
+
+const (
+	// ResidualLargestRemainder gives a unit each to the holders whose shares lost
+	// the largest fractions, the first ones on ties
+	ResidualLargestRemainder ResidualPolicy = iota
+
+	// ResidualLargestHolders gives a unit each to the largest holders, the first
+	// ones on ties
+	ResidualLargestHolders
+
+	// ResidualRetained keeps the units undistributed, e.g. with the issuer
+	ResidualRetained
+)
+
+var residualPolicyNames = []string{"LargestRemainder", "LargestHolders", "Retained"}
+
+// String returns the name of the policy, e.g. "Retained"
+func (p ResidualPolicy) String() string {
+	if p < 0 || int(p) >= len(residualPolicyNames) {
+		return fmt.Sprintf("ResidualPolicy(%d)", int(p))
+	}
+
+	return residualPolicyNames[p]
+}
+
+// OwnershipAllocation is an amount shared by ownership, and how the residual of
+// rounding was handled
+type OwnershipAllocation struct {
+	// Amounts are the shares of the holders, in their order
+	Amounts []Money
+
+	// Policy is the residual policy applied
+	Policy ResidualPolicy
+
+	// Residual is the sum of the fractions rounding the shares down left
+	Residual Money
+
+	// Retained is the part of Residual which was not distributed
+	Retained Money
+}
+
+// AllocateOwnership shares total among holders in proportion to their holdings,
+// e.g. the shares each holds of a dividend, rounding each share down and handling
+// the residual by policy. It runs in O(n log n) integer arithmetic, for cap
+// tables of any size, and fails for negative totals and holdings and for
+// holdings which add up to zero or more than an int64 holds.
+func AllocateOwnership(total Money, holdings []int64, policy ResidualPolicy) (OwnershipAllocation, error) {
+	if _, ok := currencies[total.currency]; !ok {
+		return OwnershipAllocation{}, fmt.Errorf("%w %q", ErrUnknownCurrency, total.currency)
+	}
+
+	if total.IsNegative() {
+		return OwnershipAllocation{}, fmt.Errorf("money: cannot allocate %s by ownership", total)
+	}
+
+	if policy < ResidualLargestRemainder || policy > ResidualRetained {
+		return OwnershipAllocation{}, fmt.Errorf("money: invalid residual policy %s", policy)
+	}
+
+	var sum int64
+
+	for i, h := range holdings {
+		if h < 0 || h > math.MaxInt64-sum {
+			return OwnershipAllocation{}, fmt.Errorf("money: invalid holding %d at index %d", h, i)
+		}
+
+		sum += h
+	}
+
+	if sum == 0 {
+		return OwnershipAllocation{}, fmt.Errorf("money: cannot allocate %s among no holdings", total)
+	}
+
+	units := make([]int64, len(holdings))
+	remainders := make([]uint64, len(holdings))
+	left := total.amount
+
+	for i, h := range holdings {
+		// total * h / sum fits in 128 bits, and the quotient below total
+		hi, lo := bits.Mul64(uint64(total.amount), uint64(h))
+		q, r := bits.Div64(hi, lo, uint64(sum))
+		units[i], remainders[i] = int64(q), r
+		left -= int64(q)
+	}
+
+	a := OwnershipAllocation{Policy: policy, Residual: Money{left, total.currency}, Retained: Money{0, total.currency}}
+
+	if policy == ResidualRetained {
+		a.Retained = a.Residual
+	} else if left > 0 {
+		order := make([]int, len(holdings))
+
+		for i := range order {
+			order[i] = i
+		}
+
+		sort.SliceStable(order, func(i, j int) bool {
+			if policy == ResidualLargestHolders {
+				return holdings[order[i]] > holdings[order[j]]
+			}
+
+			return remainders[order[i]] > remainders[order[j]]
+		})
+
+		// the residual is below the number of holdings with a remainder, so at
+		// most one unit goes to each holder
+		for _, i := range order[:left] {
+			units[i]++
+		}
+	}
+
+	a.Amounts = make([]Money, len(holdings))
+
+	for i, u := range units {
+		a.Amounts[i] = Money{u, total.currency}
+	}
+
+	return a, nil
+}
@@ -0,0 +1,93 @@
+package money
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAllocateOwnership(t *testing.T) {
+	holdings := []int64{1, 3, 3, 0, 3}
+
+	// 101 shared 1:3:3:0:3 is 10.1, 30.3, 30.3, 0 and 30.3, leaving 1 cent
+	values := []struct {
+		policy   ResidualPolicy
+		expected []int64
+		retained int64
+	}{
+		{ResidualLargestRemainder, []int64{10, 31, 30, 0, 30}, 0},
+		{ResidualLargestHolders, []int64{10, 31, 30, 0, 30}, 0},
+		{ResidualRetained, []int64{10, 30, 30, 0, 30}, 1},
+	}
+
+	for _, v := range values {
+		a, err := AllocateOwnership(Money{101, "USD"}, holdings, v.policy)
+
+		if err != nil || a.Policy != v.policy || a.Residual != (Money{1, "USD"}) || a.Retained != (Money{v.retained, "USD"}) {
+			t.Errorf("Expected a residual of 1 cent under %s but got %v %v", v.policy, a, err)
+			continue
+		}
+
+		for i, m := range a.Amounts {
+			if m != (Money{v.expected[i], "USD"}) {
+				t.Errorf("Expected %v under %s but got %v", v.expected, v.policy, a.Amounts)
+				break
+			}
+		}
+	}
+
+	// 2 shared 1:2 is 0.67 and 1.33: the largest fraction is the smaller holder's
+	if a, _ := AllocateOwnership(Money{2, "USD"}, []int64{1, 2}, ResidualLargestRemainder); a.Amounts[0] != (Money{1, "USD"}) {
+		t.Errorf("Expected the cent to go to the first holder but got %v", a.Amounts)
+	}
+
+	if a, _ := AllocateOwnership(Money{2, "USD"}, []int64{1, 2}, ResidualLargestHolders); a.Amounts[1] != (Money{2, "USD"}) {
+		t.Errorf("Expected the cent to go to the second holder but got %v", a.Amounts)
+	}
+}
+
+func TestAllocateOwnershipAtScale(t *testing.T) {
+	holdings := make([]int64, 100000)
+
+	for i := range holdings {
+		holdings[i] = int64(i%97 + 1)
+	}
+
+	total := Money{math.MaxInt64 / 3, "USD"}
+	a, err := AllocateOwnership(total, holdings, ResidualLargestRemainder)
+
+	if err != nil {
+		t.Fatalf("Expected no error but got %v", err)
+	}
+
+	var sum int64
+
+	for _, m := range a.Amounts {
+		sum += m.amount
+	}
+
+	if sum != total.amount {
+		t.Errorf("Expected the shares to add up to %d but got %d", total.amount, sum)
+	}
+}
+
+func TestAllocateOwnershipWhenInvalid(t *testing.T) {
+	values := []struct {
+		total    Money
+		holdings []int64
+		policy   ResidualPolicy
+	}{
+		{Money{-100, "USD"}, []int64{1}, ResidualRetained},
+		{Money{100, "USD"}, []int64{0, 0}, ResidualRetained},
+		{Money{100, "USD"}, nil, ResidualRetained},
+		{Money{100, "USD"}, []int64{1, -1}, ResidualRetained},
+		{Money{100, "USD"}, []int64{math.MaxInt64, 1}, ResidualRetained},
+		{Money{100, "USD"}, []int64{1}, ResidualPolicy(5)},
+		{Money{100, "XXX"}, []int64{1}, ResidualRetained},
+	}
+
+	for _, v := range values {
+		if _, err := AllocateOwnership(v.total, v.holdings, v.policy); err == nil {
+			t.Errorf("Expected %s among %v under %s to be rejected", v.total, v.holdings, v.policy)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Pair is a currency pair, quoting one unit of Base in units of Quote
+type Pair struct {
+	Base  string
+	Quote string
+}
+
+// ParsePair parses a currency pair written as "EURUSD", "EUR/USD", "EUR-USD" or "EUR USD".
+// Failures are reported as a *ParseError.
+func ParsePair(s string) (Pair, error) {
+	trimmed := strings.TrimSpace(s)
+	offset := strings.Index(s, trimmed)
+	code := strings.ToUpper(trimmed)
+
+	fail := func(pos int, msg, suggestion string, err error) (Pair, error) {
+		return Pair{}, &ParseError{Input: s, Pos: offset + pos, Msg: msg, Suggestion: suggestion, Err: err}
+	}
+
+	quote := 3
+
+	if len(code) > 3 && strings.ContainsAny(code[3:4], "/-_ ") {
+		quote = 4
+	}
+
+	switch {
+	case len(code) < quote+3:
+		return fail(len(code), "pair is too short", "", nil)
+	case len(code) == 7 && quote == 3:
+		return fail(3, fmt.Sprintf("invalid separator %q", code[3:4]), code[:3]+"/"+code[4:], nil)
+	case len(code) > quote+3:
+		return fail(quote+3, "unexpected trailing characters", "", nil)
+	}
+
+	p := Pair{code[:3], code[quote:]}
+
+	if _, ok := currencies[p.Base]; !ok {
+		var suggestion string
+
+		if base := suggestCurrency(p.Base); base != "" {
+			suggestion = Pair{base, p.Quote}.String()
+		}
+
+		return fail(0, fmt.Sprintf("unknown currency %s", p.Base), suggestion, ErrUnknownCurrency)
+	}
+
+	if _, ok := currencies[p.Quote]; !ok {
+		var suggestion string
+
+		if quote := suggestCurrency(p.Quote); quote != "" {
+			suggestion = Pair{p.Base, quote}.String()
+		}
+
+		return fail(quote, fmt.Sprintf("unknown currency %s", p.Quote), suggestion, ErrUnknownCurrency)
+	}
+
+	if p.Base == p.Quote {
+		return fail(quote, "same base and quote currency", "", nil)
+	}
+
+	return p, nil
+}
+
+// Inverse returns the pair with base and quote currencies swapped
+func (p Pair) Inverse() Pair {
+	return Pair{p.Quote, p.Base}
+}
+
+// String returns the pair in slash notation, e.g. "EUR/USD"
+func (p Pair) String() string {
+	return p.Base + "/" + p.Quote
+}
+
+// Validate checks both currencies are known and differ from each other
+func (p Pair) Validate() error {
+	for _, code := range []string{p.Base, p.Quote} {
+		if _, ok := currencies[code]; !ok {
+			return fmt.Errorf("%w %q in pair %s", ErrUnknownCurrency, code, p)
+		}
+	}
+
+	if p.Base == p.Quote {
+		return fmt.Errorf("money: pair %s has the same base and quote currency", p)
+	}
+
+	return nil
+}
+
+// suggestCurrency returns the known currency code one transposition or one
+// substitution away from code, or "" when there is no single candidate
+func suggestCurrency(code string) string {
+	b := []byte(code)
+
+	for i := 0; i+1 < len(b); i++ {
+		b[i], b[i+1] = b[i+1], b[i]
+
+		if _, ok := currencies[string(b)]; ok {
+			return string(b)
+		}
+
+		b[i], b[i+1] = b[i+1], b[i]
+	}
+
+	var match string
+
+	for known := range currencies {
+		if len(known) != len(code) {
+			continue
+		}
+
+		diff := 0
+
+		for i := range known {
+			if known[i] != code[i] {
+				diff++
+			}
+		}
+
+		if diff != 1 {
+			continue
+		}
+
+		if match != "" {
+			return ""
+		}
+
+		match = known
+	}
+
+	return match
+}
@@ -0,0 +1,117 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParsePair(t *testing.T) {
+	values := []string{"EURUSD", "EUR/USD", "eur/usd", "EUR-USD", "EUR USD", " EUR_USD "}
+	expected := Pair{"EUR", "USD"}
+
+	for _, value := range values {
+		p, err := ParsePair(value)
+
+		if err != nil {
+			t.Errorf("Expected %s to parse but got %s", value, err)
+		}
+
+		if p != expected {
+			t.Errorf("Expected %s to be %s but got %s", value, expected, p)
+		}
+	}
+}
+
+func TestParsePairWhenInvalid(t *testing.T) {
+	values := []string{"", "EUR", "EURUS", "EUR:USD", "EUR/USDX", "EUR/XYZ", "USD/USD"}
+
+	for _, value := range values {
+		if _, err := ParsePair(value); err == nil {
+			t.Errorf("Expected %q not to parse", value)
+		}
+	}
+}
+
+func TestPairInverse(t *testing.T) {
+	p := Pair{"EUR", "USD"}.Inverse()
+	expected := Pair{"USD", "EUR"}
+
+	if p != expected {
+		t.Errorf("Expected %s but got %s", expected, p)
+	}
+}
+
+func TestPairString(t *testing.T) {
+	if s := (Pair{"GBP", "JPY"}).String(); s != "GBP/JPY" {
+		t.Errorf("Expected GBP/JPY but got %s", s)
+	}
+}
+
+func TestParsePairErrors(t *testing.T) {
+	values := map[string]int{
+		"EUR":      3,
+		"EUR:USD":  3,
+		"EUR/USDX": 7,
+		" XYZUSD":  1,
+		"EUR/XYZ":  4,
+		"USD/USD":  4,
+	}
+
+	for value, pos := range values {
+		_, err := ParsePair(value)
+
+		var perr *ParseError
+
+		if !errors.As(err, &perr) {
+			t.Errorf("Expected %q to fail with a ParseError but got %v", value, err)
+			continue
+		}
+
+		if perr.Pos != pos {
+			t.Errorf("Expected %q to fail at %d but got %d", value, pos, perr.Pos)
+		}
+	}
+
+	if _, err := ParsePair("EUR/XYZ"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+}
+
+func TestPairValidate(t *testing.T) {
+	if err := (Pair{"EUR", "USD"}).Validate(); err != nil {
+		t.Errorf("Expected EUR/USD to be valid but got %s", err)
+	}
+
+	if err := (Pair{"EUR", "XYZ"}).Validate(); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+
+	if err := (Pair{"EUR", "EUR"}).Validate(); err == nil {
+		t.Error("Expected EUR/EUR to be invalid")
+	}
+}
+
+func TestParsePairSuggestions(t *testing.T) {
+	values := map[string]string{
+		"EUR:USD": "EUR/USD",
+		"EUE/USD": "EUR/USD",
+		"EUR/UDS": "EUR/USD",
+		"EUR/XAX": "",
+		"EUR/XYZ": "",
+	}
+
+	for value, expected := range values {
+		_, err := ParsePair(value)
+
+		var perr *ParseError
+
+		if !errors.As(err, &perr) {
+			t.Errorf("Expected %q to fail with a ParseError but got %v", value, err)
+			continue
+		}
+
+		if perr.Suggestion != expected {
+			t.Errorf("Expected %q to suggest %q but got %q", value, expected, perr.Suggestion)
+		}
+	}
+}
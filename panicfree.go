@@ -0,0 +1,94 @@
+package money
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+var panicFree struct {
+	enabled atomic.Bool
+	sync.RWMutex
+	report func(error)
+}
+
+// SetPanicFree turns panic free mode on or off. In panic free mode options of
+// the wrong type are ignored in favour of the defaults, and panics raised while
+// formatting or parsing, e.g. by a registered formatter, symbol resolver or rate
+// provider, are recovered: functions returning an error return one wrapping
+// ErrInternal, other functions their zero value. Either way report, when not
+// nil, is called with the error.
+func SetPanicFree(enabled bool, report func(error)) error {
+	return unlessFrozen(func() error {
+		panicFree.Lock()
+		panicFree.report = report
+		panicFree.Unlock()
+
+		panicFree.enabled.Store(enabled)
+
+		return nil
+	})
+}
+
+// PanicFree reports whether panic free mode is on
+func PanicFree() bool {
+	return panicFree.enabled.Load()
+}
+
+// reportInternal passes err to the panic free mode report callback
+func reportInternal(err error) {
+	panicFree.RLock()
+	report := panicFree.report
+	panicFree.RUnlock()
+
+	if report != nil {
+		report(err)
+	}
+}
+
+// guard recovers a panic in panic free mode, storing the error in err when not
+// nil. It must be deferred directly.
+func guard(err *error) {
+	if !panicFree.enabled.Load() {
+		return
+	}
+
+	r := recover()
+
+	if r == nil {
+		return
+	}
+
+	e := fmt.Errorf("%w: %v", ErrInternal, r)
+
+	if cause, ok := r.(error); ok {
+		e = fmt.Errorf("%w: %w", ErrInternal, cause)
+	}
+
+	if err != nil {
+		*err = e
+	}
+
+	reportInternal(e)
+}
+
+// validOption reports whether v has the type of the builtin option k, reporting
+// it in panic free mode when it does not
+func validOption(k string, v interface{}) bool {
+	var ok bool
+
+	switch k {
+	case "currency", "format":
+		_, ok = v.(string)
+	case "with_cents", "with_currency", "with_symbol", "with_symbol_space", "with_thousands_separator":
+		_, ok = v.(bool)
+	default:
+		return true
+	}
+
+	if !ok {
+		reportInternal(fmt.Errorf("%w: option %q must be a %T, got %T", ErrInternal, k, builtins()[k], v))
+	}
+
+	return ok
+}
@@ -0,0 +1,156 @@
+package money
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// panicFreeReports enables panic free mode for the test, collecting the reports
+func panicFreeReports(t *testing.T) *[]error {
+	reports := &[]error{}
+
+	SetPanicFree(true, func(err error) { *reports = append(*reports, err) })
+	t.Cleanup(func() { SetPanicFree(false, nil) })
+
+	return reports
+}
+
+// noPanic fails the test when fn panics
+func noPanic(t *testing.T, name string, fn func()) {
+	t.Helper()
+
+	defer func() {
+		if r := recover(); r != nil {
+			t.Errorf("Expected %s not to panic but got %v", name, r)
+		}
+	}()
+
+	fn()
+}
+
+func TestPanicFreeWithInvalidOptions(t *testing.T) {
+	reports := panicFreeReports(t)
+	invalid := []interface{}{5, "yes", true, 1.5, []string{"EUR"}, struct{}{}}
+	m := Money{123456, "EUR"}
+
+	for k := range builtins() {
+		for _, v := range invalid {
+			opts := Options{"currency": "EUR", k: v}
+			*reports = (*reports)[:0]
+
+			entries := map[string]func(){
+				"Format":           func() { Format(1234.56, opts) },
+				"FormatIntl":       func() { FormatIntl(1234.56, opts) },
+				"FormatRuby":       func() { FormatRuby(1234.56, opts) },
+				"FormatPrint":      func() { FormatPrint(1234.56, opts) },
+				"Money.Format":     func() { m.Format(opts) },
+				"Defer":            func() { Defer(1234.56, opts).Format(opts) },
+				"NewTemplate":      func() { NewTemplate(opts).Format(m) },
+				"AppendFormat":     func() { NewTemplate(opts).AppendFormat(nil, m) },
+				"Batch.Format":     func() { Batch{[]int64{1, 2}, "EUR"}.Format(opts) },
+				"FormatGap":        func() { FormatGap(m, Money{0, "EUR"}, "{amount}", opts) },
+				"FormatDual":       func() { FormatDual(m, time.Now(), opts) },
+				"CompareFormats":   func() { CompareFormats(opts, Options{}, []Money{m}) },
+				"Parse":            func() { Parse("€1.234,56", opts) },
+				"ParseCache.Parse": func() { NewParseCache(1).Parse("€1.234,56", opts) },
+				"NewScanner":       func() { NewScanner(strings.NewReader("€1,00\n€2,00"), '\n', opts).Scan() },
+				"CSVWriter.Write":  func() { NewCSVWriter(&bytes.Buffer{}, ';', opts).Write(nil, 1234.56) },
+			}
+
+			for name, fn := range entries {
+				noPanic(t, name+" with "+k, fn)
+			}
+
+			if fmt.Sprintf("%T", v) == fmt.Sprintf("%T", builtins()[k]) {
+				continue
+			}
+
+			if len(*reports) == 0 || !errors.Is((*reports)[0], ErrInternal) {
+				t.Errorf("Expected option %s = %#v to be reported but got %v", k, v, *reports)
+			}
+		}
+	}
+}
+
+func TestPanicFreeIgnoresInvalidOptions(t *testing.T) {
+	panicFreeReports(t)
+
+	values := []struct {
+		opts     Options
+		expected string
+	}{
+		{Options{"with_cents": "no"}, "$1,234.56"},
+		{Options{"currency": "EUR", "with_symbol": 1}, "€1.234,56"},
+		{Options{"currency": 978, "format": 5}, "$1,234.56"},
+	}
+
+	for _, v := range values {
+		if result := Format(1234.56, v.opts); result != v.expected {
+			t.Errorf("Expected %s but got %s", v.expected, result)
+		}
+	}
+}
+
+func TestPanicFreeWithPanickingCallbacks(t *testing.T) {
+	reports := panicFreeReports(t)
+	explode := func() { panic("boom") }
+
+	RegisterFormat("panicking", func(float64, Options) string { explode(); return "" })
+
+	if result := Format(1, Options{"format": "panicking"}); result != "" {
+		t.Errorf("Expected an empty result but got %s", result)
+	}
+
+	SetSymbolResolver(SymbolResolverFunc(func(code, symbol string) string { explode(); return symbol }))
+
+	noPanic(t, "Format", func() { Format(1) })
+
+	if template := NewTemplate(); template != nil {
+		t.Errorf("Expected no template but got %v", template)
+	}
+
+	if _, err := Parse("$1.00"); !errors.Is(err, ErrInternal) {
+		t.Errorf("Expected ErrInternal but got %v", err)
+	}
+
+	SetSymbolResolver(nil)
+
+	e, _ := NewExchange("EUR", RateProviderFunc(func(from, to string) (float64, error) { explode(); return 0, nil }))
+
+	if _, err := e.Rate("EUR", "USD"); !errors.Is(err, ErrInternal) {
+		t.Errorf("Expected ErrInternal but got %v", err)
+	}
+
+	s := NewScanner(strings.NewReader("$1.00"), '\n')
+	s.SetProgress(1, func(int64, int) { explode() })
+
+	if s.Scan() || !errors.Is(s.Err(), ErrInternal) {
+		t.Errorf("Expected ErrInternal but got %v", s.Err())
+	}
+
+	if len(*reports) != 6 {
+		t.Errorf("Expected 6 reports but got %d", len(*reports))
+	}
+
+	for _, err := range *reports {
+		if err.Error() != "money: internal error: boom" {
+			t.Errorf("Expected money: internal error: boom but got %s", err)
+		}
+	}
+}
+
+func TestPanicFreeWhenDisabled(t *testing.T) {
+	SetPanicFree(false, nil)
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected an invalid option to panic outside panic free mode")
+		}
+	}()
+
+	Format(1, Options{"with_cents": "no"})
+}
@@ -0,0 +1,454 @@
+package money
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxParseLength bounds the bytes of input Parse reads. Longer input, which no
+// amount needs even with surrounding white space, fails before it is scanned,
+// bounding the time spent on untrusted input such as uploaded CSV files.
+const MaxParseLength = 256
+
+// Parse reads a formatted price string such as "$1,234.56", "1.234,56 €" or
+// "10.00 USD" back into Money, so that Parse(Format(x, o), o) round trips.
+//
+// The currency comes from an ISO code or symbol in s, falling back to the
+// "currency" option. A symbol shared by several currencies, like "$", resolves
+// to the option currency when it is one of them, and is ambiguous otherwise.
+// The amount must use the decimal mark and thousands separator of the currency.
+// Failures are reported as a *ParseError, suggesting the input with decimal mark
+// and thousands separator swapped when that would parse.
+func Parse(s string, opts ...Options) (m Money, err error) {
+	defer guard(&err)
+
+	options := defaults()
+
+	if len(opts) > 0 {
+		noteDeprecated(opts[0])
+		options = override(options, opts[0])
+	}
+
+	return parseObserved(s, options["currency"].(string), false)
+}
+
+// ParseWithCurrency is like Parse but requires the amount to be in the currency,
+// failing with ErrCurrencyMismatch when s holds another ISO code or symbol
+func ParseWithCurrency(s, code string) (m Money, err error) {
+	defer guard(&err)
+
+	code = strings.ToUpper(code)
+
+	if _, ok := currencies[code]; !ok {
+		return Money{}, fmt.Errorf("%w %q", ErrUnknownCurrency, code)
+	}
+
+	return parseObserved(s, code, true)
+}
+
+// parser tracks the part of the input, from start to end, left to be read
+type parser struct {
+	input      string
+	start, end int
+}
+
+func parse(s, hint string, strict, suggest bool) (Money, error) {
+	p := &parser{s, 0, len(s)}
+
+	fail := func(pos int, msg string, err error) (Money, error) {
+		return Money{}, &ParseError{Input: s, Pos: pos, Msg: msg, Err: err}
+	}
+
+	if len(s) > MaxParseLength {
+		return fail(MaxParseLength, fmt.Sprintf("input longer than %d bytes", MaxParseLength), nil)
+	}
+
+	p.trimSpace()
+
+	if p.start == p.end {
+		return fail(p.start, "missing amount", nil)
+	}
+
+	negative := p.consume("-")
+
+	code, codePos := p.isoCode()
+	symbol, symbolPos := p.symbol(hint)
+
+	if !negative {
+		negative = p.consume("-")
+	}
+
+	cur := hint
+
+	switch {
+	case code != "":
+		if strict && code != hint {
+			return fail(codePos, fmt.Sprintf("currency %s is not %s", code, hint), ErrCurrencyMismatch)
+		}
+
+		if symbol != "" && !ownsSymbol(code, symbol) {
+			return fail(symbolPos, fmt.Sprintf("symbol %s is not a %s symbol", symbol, code), ErrCurrencyMismatch)
+		}
+
+		cur = code
+	case symbol != "" && !ownsSymbol(hint, symbol):
+		owners := symbolOwners()[symbol]
+
+		switch {
+		case strict:
+			return fail(symbolPos, fmt.Sprintf("symbol %s is not a %s symbol", symbol, hint), ErrCurrencyMismatch)
+		case len(owners) != 1:
+			return fail(symbolPos, fmt.Sprintf("symbol %s is ambiguous between %s", symbol, strings.Join(owners, ", ")), nil)
+		}
+
+		cur = owners[0]
+	}
+
+	if _, ok := currencies[cur]; !ok {
+		return fail(p.start, fmt.Sprintf("unknown currency %q", cur), ErrUnknownCurrency)
+	}
+
+	amount, err := p.number(cur)
+
+	if err != nil {
+		if suggest {
+			err.Suggestion = p.swapped(cur, hint, strict)
+		}
+
+		return Money{}, err
+	}
+
+	if negative {
+		amount = -amount
+	}
+
+	return Money{amount, cur}, nil
+}
+
+// trimSpace skips white space around the remaining input
+func (p *parser) trimSpace() {
+	for p.start < p.end {
+		r, size := utf8.DecodeRuneInString(p.input[p.start:p.end])
+
+		if !unicode.IsSpace(r) {
+			break
+		}
+
+		p.start += size
+	}
+
+	for p.end > p.start {
+		r, size := utf8.DecodeLastRuneInString(p.input[p.start:p.end])
+
+		if !unicode.IsSpace(r) {
+			break
+		}
+
+		p.end -= size
+	}
+}
+
+// consume skips prefix when the remaining input starts with it
+func (p *parser) consume(prefix string) bool {
+	if !strings.HasPrefix(p.input[p.start:p.end], prefix) {
+		return false
+	}
+
+	p.start += len(prefix)
+	p.trimSpace()
+
+	return true
+}
+
+// isoCode reads a known ISO currency code prefixing or suffixing the remaining input
+func (p *parser) isoCode() (string, int) {
+	text := p.input[p.start:p.end]
+
+	if len(text) < 3 {
+		return "", 0
+	}
+
+	if code := strings.ToUpper(text[len(text)-3:]); isLetters(code) && (len(text) == 3 || !isLetterBefore(text, len(text)-3)) {
+		if _, ok := currencies[code]; ok {
+			pos := p.end - 3
+			p.end = pos
+			p.trimSpace()
+
+			return code, pos
+		}
+	}
+
+	if code := strings.ToUpper(text[:3]); isLetters(code) && (len(text) == 3 || !isLetterAt(text, 3)) {
+		if _, ok := currencies[code]; ok {
+			pos := p.start
+			p.start += 3
+			p.trimSpace()
+
+			return code, pos
+		}
+	}
+
+	return "", 0
+}
+
+// symbol reads the longest currency symbol prefixing or suffixing the remaining
+// input, trying the displayed symbol of the hint currency first
+func (p *parser) symbol(hint string) (string, int) {
+	candidates := append([]string{resolveSymbol(hint, currencies[hint])}, symbolsByLength()...)
+
+	for _, symbol := range candidates {
+		text := p.input[p.start:p.end]
+
+		if symbol == "" || len(symbol) >= len(text) {
+			continue
+		}
+
+		if strings.HasPrefix(text, symbol) {
+			pos := p.start
+			p.start += len(symbol)
+			p.trimSpace()
+
+			return symbol, pos
+		}
+
+		if strings.HasSuffix(text, symbol) {
+			pos := p.end - len(symbol)
+			p.end = pos
+			p.trimSpace()
+
+			return symbol, pos
+		}
+	}
+
+	return "", 0
+}
+
+// number reads the remaining input as an amount in minor units of the currency
+func (p *parser) number(code string) (int64, *ParseError) {
+	c := currencies[code]
+	digits := c.exponent()
+	text := p.input[p.start:p.end]
+
+	fail := func(i int, msg string, err error) (int64, *ParseError) {
+		return 0, &ParseError{Input: p.input, Pos: p.start + i, Msg: msg, Err: err}
+	}
+
+	if text == "" {
+		return fail(0, "missing amount", nil)
+	}
+
+	var integer, fractional []byte
+
+	decimal := -1
+	group, groups := 0, 0
+
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+
+		switch sep := separatorAt(text[i:], c.ThousandsSeparator); {
+		case r >= '0' && r <= '9' && decimal >= 0:
+			fractional = append(fractional, byte(r))
+		case r >= '0' && r <= '9':
+			integer = append(integer, byte(r))
+			group++
+		case strings.HasPrefix(text[i:], c.DecimalMark) && decimal < 0:
+			if groups > 0 && group != 3 {
+				return fail(i, "misplaced thousands separator", nil)
+			}
+
+			decimal = i
+			size = len(c.DecimalMark)
+		case strings.HasPrefix(text[i:], c.DecimalMark):
+			return fail(i, "unexpected second decimal mark", nil)
+		case sep > 0 && decimal < 0:
+			if group == 0 || groups == 0 && group > 3 || groups > 0 && group != 3 {
+				return fail(i, "misplaced thousands separator", nil)
+			}
+
+			group, groups = 0, groups+1
+			size = sep
+		case sep > 0:
+			return fail(i, "thousands separator after decimal mark", nil)
+		default:
+			return fail(i, fmt.Sprintf("unexpected character %q", r), nil)
+		}
+
+		i += size
+	}
+
+	switch {
+	case len(integer) == 0:
+		return fail(0, "missing integer digits", nil)
+	case groups > 0 && decimal < 0 && group != 3:
+		return fail(len(text)-group, "misplaced thousands separator", nil)
+	case decimal >= 0 && len(fractional) == 0:
+		return fail(len(text), "missing fraction digits", nil)
+	}
+
+	for i := digits; i < len(fractional); i++ {
+		if fractional[i] != '0' {
+			return fail(decimal+len(c.DecimalMark)+digits, fmt.Sprintf("%s has %d fraction digits", code, digits), nil)
+		}
+	}
+
+	value := string(integer)
+
+	if len(fractional) > digits {
+		fractional = fractional[:digits]
+	}
+
+	if len(fractional) > 0 {
+		value += "." + string(fractional)
+	}
+
+	decimals, err := parseUnits(value, digits)
+
+	if err != nil {
+		return fail(0, "amount too large", ErrOverflow)
+	}
+
+	amount, err := decimalsToUnits(decimals, digits, c.units())
+
+	if err != nil {
+		return fail(0, "amount too large", ErrOverflow)
+	}
+
+	return amount, nil
+}
+
+// swapped returns the input with decimal mark and thousands separator of the
+// amount swapped, when that parses, as a suggestion for a failed input
+func (p *parser) swapped(code, hint string, strict bool) string {
+	c := currencies[code]
+
+	if c.ThousandsSeparator == "" || c.ThousandsSeparator == c.DecimalMark {
+		return ""
+	}
+
+	r := strings.NewReplacer(c.DecimalMark, c.ThousandsSeparator, c.ThousandsSeparator, c.DecimalMark)
+	s := p.input[:p.start] + r.Replace(p.input[p.start:p.end]) + p.input[p.end:]
+
+	if _, err := parse(s, hint, strict, false); err != nil {
+		return ""
+	}
+
+	return s
+}
+
+// separatorAt returns the length of the thousands separator starting text, or 0.
+// A space separator also matches any other white space, such as a no-break space.
+func separatorAt(text, separator string) int {
+	if separator == "" {
+		return 0
+	}
+
+	if strings.HasPrefix(text, separator) {
+		return len(separator)
+	}
+
+	if r, size := utf8.DecodeRuneInString(text); separator == " " && unicode.IsSpace(r) {
+		return size
+	}
+
+	return 0
+}
+
+// ownsSymbol reports whether symbol is displayed or alternatively used for the currency
+func ownsSymbol(code, symbol string) bool {
+	c, ok := currencies[code]
+
+	if !ok {
+		return false
+	}
+
+	if symbol == c.Symbol || symbol == resolveSymbol(code, c) {
+		return true
+	}
+
+	for _, alternate := range c.AlternateSymbols {
+		if symbol == alternate {
+			return true
+		}
+	}
+
+	return false
+}
+
+var symbolIndex struct {
+	sync.Once
+	owners   map[string][]string
+	byLength []string
+}
+
+func indexSymbols() {
+	symbolIndex.owners = map[string][]string{}
+
+	for code, c := range currencies {
+		for _, symbol := range append([]string{c.Symbol}, c.AlternateSymbols...) {
+			if symbol != "" {
+				symbolIndex.owners[symbol] = append(symbolIndex.owners[symbol], code)
+			}
+		}
+	}
+
+	for symbol, owners := range symbolIndex.owners {
+		sort.Strings(owners)
+		owners = dedupe(owners)
+		symbolIndex.owners[symbol] = owners
+		symbolIndex.byLength = append(symbolIndex.byLength, symbol)
+	}
+
+	sort.Slice(symbolIndex.byLength, func(i, j int) bool {
+		a, b := symbolIndex.byLength[i], symbolIndex.byLength[j]
+		return len(a) > len(b) || len(a) == len(b) && a < b
+	})
+}
+
+// symbolOwners maps each registry symbol to the sorted codes of the currencies using it
+func symbolOwners() map[string][]string {
+	symbolIndex.Do(indexSymbols)
+	return symbolIndex.owners
+}
+
+// symbolsByLength returns the registry symbols, longest first
+func symbolsByLength() []string {
+	symbolIndex.Do(indexSymbols)
+	return symbolIndex.byLength
+}
+
+// dedupe removes adjacent duplicates from a sorted slice
+func dedupe(values []string) []string {
+	result := values[:0]
+
+	for i, v := range values {
+		if i == 0 || v != values[i-1] {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+func isLetters(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'A' || s[i] > 'Z' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isLetterAt(s string, i int) bool {
+	r, _ := utf8.DecodeRuneInString(s[i:])
+	return unicode.IsLetter(r)
+}
+
+func isLetterBefore(s string, i int) bool {
+	r, _ := utf8.DecodeLastRuneInString(s[:i])
+	return unicode.IsLetter(r)
+}
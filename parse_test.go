@@ -0,0 +1,234 @@
+package money
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	values := []struct {
+		input    string
+		options  Options
+		expected Money
+	}{
+		{"$1,234.56", Options{}, Money{123456, "USD"}},
+		{"-$1,234.56", Options{}, Money{-123456, "USD"}},
+		{"$-10.00", Options{}, Money{-1000, "USD"}},
+		{"  $ 10  ", Options{}, Money{1000, "USD"}},
+		{"1234.5", Options{}, Money{123450, "USD"}},
+		{"10.00 USD", Options{}, Money{1000, "USD"}},
+		{"usd 10.00", Options{}, Money{1000, "USD"}},
+		{"€1.234,56 EUR", Options{}, Money{123456, "EUR"}},
+		{"1.234,56 €", Options{}, Money{123456, "EUR"}},
+		{"$10.00", Options{"currency": "CAD"}, Money{1000, "CAD"}},
+		{"C$10.00", Options{"currency": "CAD"}, Money{1000, "CAD"}},
+		{"R$10,00", Options{}, Money{1000, "BRL"}},
+		{"10.00 CAD", Options{}, Money{1000, "CAD"}},
+		{"¥1,234", Options{"currency": "JPY"}, Money{1234, "JPY"}},
+		{"Ar1.4", Options{}, Money{7, "MGA"}},
+		{"1.230", Options{}, Money{123, "USD"}},
+		{"1\u202f234,50\u00a0kr", Options{"currency": "SEK"}, Money{123450, "SEK"}},
+		{"1 234,50 kr", Options{"currency": "SEK"}, Money{123450, "SEK"}},
+	}
+
+	for _, v := range values {
+		m, err := Parse(v.input, v.options)
+
+		if err != nil {
+			t.Errorf("Expected %q to parse but got %s", v.input, err)
+			continue
+		}
+
+		if m != v.expected {
+			t.Errorf("Expected %q to be %d %s but got %d %s", v.input, v.expected.amount, v.expected.currency, m.amount, m.currency)
+		}
+	}
+}
+
+func TestParseWhenInvalid(t *testing.T) {
+	values := []struct {
+		input      string
+		options    Options
+		pos        int
+		suggestion string
+	}{
+		{"", Options{}, 0, ""},
+		{"$", Options{}, 0, ""},
+		{"$1.234.567,89", Options{}, 6, "$1,234,567.89"},
+		{"$1.234.56", Options{}, 6, ""},
+		{"$1.234", Options{}, 5, "$1,234"},
+		{"€1,234.56", Options{}, 8, "€1.234,56"},
+		{"¥10", Options{}, 0, ""},
+		{"$1,23,456", Options{}, 5, ""},
+		{"$1234,567", Options{}, 5, ""},
+		{"$12x", Options{}, 3, ""},
+		{"$10.", Options{}, 4, ""},
+		{"$10.00", Options{"currency": "EUR"}, 0, ""},
+		{"€10.00 USD", Options{}, 0, ""},
+	}
+
+	for _, v := range values {
+		_, err := Parse(v.input, v.options)
+
+		var perr *ParseError
+
+		if !errors.As(err, &perr) {
+			t.Errorf("Expected %q to fail with a ParseError but got %v", v.input, err)
+			continue
+		}
+
+		if perr.Pos != v.pos || perr.Suggestion != v.suggestion {
+			t.Errorf("Expected %q to fail at %d suggesting %q but got %d %q", v.input, v.pos, v.suggestion, perr.Pos, perr.Suggestion)
+		}
+	}
+
+	if _, err := Parse("$92,233,720,368,547,758.08"); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow but got %v", err)
+	}
+}
+
+func TestParseWithCurrency(t *testing.T) {
+	m, err := ParseWithCurrency("1.234,56 €", "eur")
+
+	if err != nil || m != (Money{123456, "EUR"}) {
+		t.Errorf("Expected 123456 EUR but got %d %s %v", m.amount, m.currency, err)
+	}
+
+	if m, err := ParseWithCurrency("$10.00", "cad"); err != nil || m != (Money{1000, "CAD"}) {
+		t.Errorf("Expected 1000 CAD but got %d %s %v", m.amount, m.currency, err)
+	}
+
+	for _, input := range []string{"10.00 USD", "$10.00"} {
+		if _, err := ParseWithCurrency(input, "EUR"); !errors.Is(err, ErrCurrencyMismatch) {
+			t.Errorf("Expected %q to fail with ErrCurrencyMismatch but got %v", input, err)
+		}
+	}
+
+	if _, err := ParseWithCurrency("10.00", "XYZ"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+}
+
+func TestParseRoundTrip(t *testing.T) {
+	options := []Options{
+		{},
+		{"with_currency": true},
+		{"with_symbol": false},
+		{"with_symbol_space": true},
+		{"with_thousands_separator": false},
+	}
+
+	for code := range currencies {
+		for _, amount := range []int64{0, 7, -123456789, 100000000} {
+			m := Money{amount, code}
+
+			for _, o := range options {
+				o = override(Options{"currency": code}, o)
+				s := m.Format(o)
+
+				if parsed, err := Parse(s, o); err != nil || parsed != m {
+					t.Errorf("Expected %q to parse to %d %s but got %d %s %v", s, amount, code, parsed.amount, parsed.currency, err)
+				}
+			}
+		}
+	}
+}
+
+func TestParseRoundTripWithFloats(t *testing.T) {
+	for code := range currencies {
+		for _, val := range []float64{0, 0.7, 1.5, 19.99, -1234.5678, 1e9} {
+			o := Options{"currency": code}
+			s := Format(val, o)
+
+			if parsed, err := Parse(s, o); err != nil || parsed.Format(o) != s {
+				t.Errorf("Expected %q formatted from %v %s to round trip but got %s %v", s, val, code, parsed.Format(o), err)
+			}
+		}
+	}
+}
+
+// adversarial holds inputs crafted to slow down or crash a parser
+var adversarial = []string{
+	strings.Repeat(",", MaxParseLength),
+	strings.Repeat(".", MaxParseLength-1) + "1",
+	strings.Repeat("$", MaxParseLength-1) + "1",
+	"$" + strings.Repeat("1,", 120) + "000",
+	strings.Repeat("9", MaxParseLength),
+	strings.Repeat(" ", MaxParseLength-1) + "1",
+	strings.Repeat("\u00a0", 60) + "1 EUR",
+	strings.Repeat("USD", 80) + "1",
+	"1" + strings.Repeat("0", 200) + ".00",
+	"-" + strings.Repeat("-", 100) + "1",
+	"\xff\xfe1.00",
+	"1.00 \u20ac\u20ac",
+}
+
+func TestParseWhenLong(t *testing.T) {
+	for _, pattern := range []string{",", "$", "1", " ", "1,000", "\u00a0"} {
+		s := strings.Repeat(pattern, 10<<20/len(pattern))
+		_, err := Parse(s)
+
+		var perr *ParseError
+
+		if !errors.As(err, &perr) || perr.Pos != MaxParseLength || !strings.HasSuffix(err.Error(), "input longer than 256 bytes") {
+			t.Errorf("Expected %.8q... to be too long but got %.200v", s, err)
+			continue
+		}
+
+		if len(err.Error()) > 1024 {
+			t.Errorf("Expected the error for %.8q... to be truncated but got %d bytes", s, len(err.Error()))
+		}
+	}
+
+	for _, s := range adversarial {
+		if _, err := Parse(s); err != nil {
+			if _, ok := err.(*ParseError); !ok {
+				t.Errorf("Expected a *ParseError for %.16q... but got %v", s, err)
+			}
+		}
+	}
+}
+
+func BenchmarkParseWhenLong(b *testing.B) {
+	s := strings.Repeat("1,000", 2<<20)
+
+	for i := 0; i < b.N; i++ {
+		Parse(s)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	for _, s := range append(adversarial, "$1,234.56", "1.234,56 \u20ac", "10.00 USD", "-\u00a51,000", "CHF 1'234.50", "") {
+		f.Add(s, "USD")
+		f.Add(s, "EUR")
+	}
+
+	f.Fuzz(func(t *testing.T, s, hint string) {
+		m, err := Parse(s, Options{"currency": hint})
+
+		if err != nil {
+			if _, ok := err.(*ParseError); !ok {
+				t.Fatalf("Expected a *ParseError for %q but got %v", s, err)
+			}
+
+			return
+		}
+
+		formatted := m.Format(Options{"with_currency": true})
+
+		if parsed, err := Parse(formatted); err != nil || parsed != m {
+			t.Fatalf("Expected %q parsed from %q to round trip but got %v %v", formatted, s, parsed, err)
+		}
+	})
+}
+
+func TestParseWithUnknownCurrency(t *testing.T) {
+	if _, err := Parse("1.00", Options{"currency": "XYZ"}); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+
+	if m, err := Parse("€1,00", Options{"currency": "XYZ"}); err != nil || m != (Money{100, "EUR"}) {
+		t.Errorf("Expected €1,00 but got %s (%v)", m, err)
+	}
+}
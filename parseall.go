@@ -0,0 +1,85 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maxMultiErrorLines bounds the line errors quoted by MultiError.Error
+const maxMultiErrorLines = 10
+
+// LineError is the failure to parse one line of a bulk import
+type LineError struct {
+	// Line is the 1-based index of the line
+	Line int
+	Err  error
+}
+
+// Error returns the line number followed by the failure
+func (e *LineError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Err)
+}
+
+// Unwrap returns the failure, usually a *ParseError
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+// MultiError collects the failures of every line of a bulk import, in line order
+type MultiError struct {
+	Errors []*LineError
+}
+
+// Error returns the number of failed lines and the first failures
+func (e *MultiError) Error() string {
+	msgs := make([]string, 0, maxMultiErrorLines)
+
+	for i, err := range e.Errors {
+		if i == maxMultiErrorLines {
+			msgs = append(msgs, fmt.Sprintf("and %d more", len(e.Errors)-i))
+			break
+		}
+
+		msgs = append(msgs, err.Error())
+	}
+
+	return fmt.Sprintf("money: %d lines failed: %s", len(e.Errors), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the line errors, for errors.Is and errors.As
+func (e *MultiError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+
+	return errs
+}
+
+// ParseAll parses every line as Parse does, carrying on past failures. The
+// amounts are in line order, the zero Money for the lines that failed, and
+// the error, nil when all lines parsed, reports every failure.
+func ParseAll(lines []string, opts ...Options) ([]Money, *MultiError) {
+	noteDeprecated(opts...)
+
+	amounts := make([]Money, len(lines))
+	var failures []*LineError
+
+	for i, line := range lines {
+		m, err := Parse(line, opts...)
+
+		if err != nil {
+			failures = append(failures, &LineError{i + 1, err})
+			continue
+		}
+
+		amounts[i] = m
+	}
+
+	if failures == nil {
+		return amounts, nil
+	}
+
+	return amounts, &MultiError{failures}
+}
@@ -0,0 +1,49 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseAll(t *testing.T) {
+	amounts, err := ParseAll([]string{"$1.00", "€1.234,56", "10.00 USD"})
+
+	if err != nil {
+		t.Fatalf("Expected no error but got %v", err)
+	}
+
+	if expected := []Money{{100, "USD"}, {123456, "EUR"}, {1000, "USD"}}; !reflect.DeepEqual(amounts, expected) {
+		t.Errorf("Expected %v but got %v", expected, amounts)
+	}
+}
+
+func TestParseAllWhenInvalid(t *testing.T) {
+	amounts, err := ParseAll([]string{"1.00 USD", "abc", "€1,234.56", "2,50"}, Options{"currency": "EUR"})
+
+	if err == nil || len(err.Errors) != 2 || err.Errors[0].Line != 2 || err.Errors[1].Line != 3 {
+		t.Fatalf("Expected lines 2 and 3 to fail but got %v", err)
+	}
+
+	if expected := []Money{{100, "USD"}, {}, {}, {250, "EUR"}}; !reflect.DeepEqual(amounts, expected) {
+		t.Errorf("Expected %v but got %v", expected, amounts)
+	}
+
+	var perr *ParseError
+
+	if !errors.As(err, &perr) || !strings.HasPrefix(err.Error(), "money: 2 lines failed: line 2: money: cannot parse") {
+		t.Errorf("Expected a *ParseError in %v", err)
+	}
+
+	lines := make([]string, 25)
+
+	for i := range lines {
+		lines[i] = fmt.Sprint("x", i)
+	}
+
+	if _, err := ParseAll(lines); !strings.HasSuffix(err.Error(), "; and 15 more") || len(err.Errors) != 25 {
+		t.Errorf("Expected the error to be shortened but got %v", err)
+	}
+}
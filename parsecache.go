@@ -0,0 +1,128 @@
+package money
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+)
+
+// ParseCache memoizes Parse for repeated identical inputs, such as the amounts
+// of a feed, keeping at most Size results and evicting the least recently used.
+// It is safe for concurrent use. Cached results do not follow a later
+// SetSymbolResolver.
+type ParseCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[parseKey]*list.Element
+	order   *list.List
+	stats   ParseCacheStats
+}
+
+// ParseCacheStats counts the lookups of a ParseCache
+type ParseCacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Len       int
+}
+
+type parseKey struct {
+	input  string
+	hint   string
+	strict bool
+}
+
+type parseResult struct {
+	key parseKey
+	m   Money
+	err error
+}
+
+// NewParseCache returns a ParseCache holding up to size results, at least one
+func NewParseCache(size int) *ParseCache {
+	if size < 1 {
+		size = 1
+	}
+
+	return &ParseCache{size: size, entries: map[parseKey]*list.Element{}, order: list.New()}
+}
+
+// Parse is like Parse, returning the cached result of an identical call
+func (c *ParseCache) Parse(s string, opts ...Options) (m Money, err error) {
+	defer guard(&err)
+
+	options := defaults()
+
+	if len(opts) > 0 {
+		noteDeprecated(opts[0])
+		options = override(options, opts[0])
+	}
+
+	return c.parse(parseKey{s, options["currency"].(string), false})
+}
+
+// ParseWithCurrency is like ParseWithCurrency, returning the cached result of an
+// identical call
+func (c *ParseCache) ParseWithCurrency(s, code string) (m Money, err error) {
+	defer guard(&err)
+
+	code = strings.ToUpper(code)
+
+	if _, ok := currencies[code]; !ok {
+		return ParseWithCurrency(s, code)
+	}
+
+	return c.parse(parseKey{s, code, true})
+}
+
+// Stats returns the lookups counted so far and the number of cached results
+func (c *ParseCache) Stats() ParseCacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := c.stats
+	stats.Len = c.order.Len()
+
+	return stats
+}
+
+func (c *ParseCache) parse(key parseKey) (Money, error) {
+	if len(key.input) > MaxParseLength {
+		// rejected without scanning, and not worth holding on to
+		return parseObserved(key.input, key.hint, key.strict)
+	}
+
+	c.mu.Lock()
+
+	if e, ok := c.entries[key]; ok {
+		c.order.MoveToFront(e)
+		c.stats.Hits++
+		r := e.Value.(*parseResult)
+		c.mu.Unlock()
+		observeCacheLookup("parse", true)
+
+		return r.m, r.err
+	}
+
+	c.stats.Misses++
+	c.mu.Unlock()
+	observeCacheLookup("parse", false)
+
+	m, err := parseObserved(key.input, key.hint, key.strict)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[key]; !ok {
+		c.entries[key] = c.order.PushFront(&parseResult{key, m, err})
+
+		if c.order.Len() > c.size {
+			oldest := c.order.Back()
+			c.order.Remove(oldest)
+			delete(c.entries, oldest.Value.(*parseResult).key)
+			c.stats.Evictions++
+		}
+	}
+
+	return m, err
+}
@@ -0,0 +1,77 @@
+package money
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestParseCache(t *testing.T) {
+	c := NewParseCache(2)
+
+	for i := 0; i < 3; i++ {
+		if m, err := c.Parse("$1,234.56"); err != nil || m != (Money{123456, "USD"}) {
+			t.Errorf("Expected 123456 USD but got %d %s %v", m.amount, m.currency, err)
+		}
+	}
+
+	if m, _ := c.Parse("$10.00", Options{"currency": "CAD"}); m != (Money{1000, "CAD"}) {
+		t.Errorf("Expected the currency option to be part of the key but got %s", m.currency)
+	}
+
+	if _, err := c.Parse("$1.234"); err == nil {
+		t.Error("Expected the cached parse to fail")
+	}
+
+	var perr *ParseError
+
+	if _, err := c.Parse("$1.234"); !errors.As(err, &perr) || perr.Suggestion != "$1,234" {
+		t.Errorf("Expected the cached ParseError but got %v", err)
+	}
+
+	expected := ParseCacheStats{Hits: 3, Misses: 3, Evictions: 1, Len: 2}
+
+	if stats := c.Stats(); stats != expected {
+		t.Errorf("Expected %+v but got %+v", expected, stats)
+	}
+}
+
+func TestParseCacheWithCurrency(t *testing.T) {
+	c := NewParseCache(10)
+
+	if m, err := c.ParseWithCurrency("$10.00", "cad"); err != nil || m != (Money{1000, "CAD"}) {
+		t.Errorf("Expected 1000 CAD but got %d %s %v", m.amount, m.currency, err)
+	}
+
+	if _, err := c.ParseWithCurrency("$10.00", "EUR"); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+
+	if _, err := c.ParseWithCurrency("10.00", "XYZ"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+}
+
+func TestParseCacheConcurrently(t *testing.T) {
+	c := NewParseCache(4)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for _, s := range []string{"$1.00", "$2.00", "$3.00", "$4.00", "$5.00"} {
+				c.Parse(s)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if stats := c.Stats(); stats.Hits+stats.Misses != 40 || stats.Len != 4 {
+		t.Errorf("Expected 40 lookups and 4 results but got %+v", stats)
+	}
+}
@@ -0,0 +1,103 @@
+package money
+
+import (
+	"fmt"
+	"sync"
+)
+
+// PayoutState is the rotation state of a PayoutAllocator, to persist between
+// allocations
+type PayoutState struct {
+	Recipients []string `json:"recipients"`
+
+	// Next is the index of the recipient first in line for a remainder unit
+	Next int `json:"next"`
+}
+
+// PayoutAllocator allocates repeated payouts to the same recipients. Where
+// Money.Allocate always hands the remainder to the first parts, it hands it out
+// in turn, continuing after the last recipient handed a unit, so no recipient
+// absorbs a rounding unit twice before every other recipient has absorbed one.
+type PayoutAllocator struct {
+	mu    sync.Mutex
+	state PayoutState
+	save  func(PayoutState) error
+}
+
+// NewPayoutAllocator returns a PayoutAllocator resuming from state, e.g. as last
+// saved, with Next 0 for a new rotation. After each allocation it calls save,
+// when not nil, with the new state; when save fails the allocation fails and
+// the rotation does not advance.
+func NewPayoutAllocator(state PayoutState, save func(PayoutState) error) (*PayoutAllocator, error) {
+	if len(state.Recipients) == 0 {
+		return nil, fmt.Errorf("money: payout allocator requires recipients")
+	}
+
+	if state.Next < 0 || state.Next >= len(state.Recipients) {
+		return nil, fmt.Errorf("money: payout state next %d is not a recipient index", state.Next)
+	}
+
+	seen := map[string]bool{}
+
+	for _, r := range state.Recipients {
+		if seen[r] {
+			return nil, fmt.Errorf("money: payout recipient %q is listed twice", r)
+		}
+
+		seen[r] = true
+	}
+
+	state.Recipients = append([]string(nil), state.Recipients...)
+
+	return &PayoutAllocator{state: state, save: save}, nil
+}
+
+// Allocate divides m among the recipients proportionally to ratios, one per
+// recipient, returning the parts in recipient order
+func (a *PayoutAllocator) Allocate(m Money, ratios ...int) ([]Money, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if len(ratios) != len(a.state.Recipients) {
+		return nil, fmt.Errorf("money: %d ratios for %d payout recipients", len(ratios), len(a.state.Recipients))
+	}
+
+	parts, next, err := m.allocate(ratios, a.state.Next)
+
+	if err != nil {
+		return nil, err
+	}
+
+	state := PayoutState{a.state.Recipients, next}
+
+	if a.save != nil {
+		if err := a.save(PayoutState{append([]string(nil), state.Recipients...), next}); err != nil {
+			return nil, fmt.Errorf("money: cannot save payout state: %w", err)
+		}
+	}
+
+	a.state = state
+
+	return parts, nil
+}
+
+// Split divides m equally among the recipients
+func (a *PayoutAllocator) Split(m Money) ([]Money, error) {
+	a.mu.Lock()
+	ratios := make([]int, len(a.state.Recipients))
+	a.mu.Unlock()
+
+	for i := range ratios {
+		ratios[i] = 1
+	}
+
+	return a.Allocate(m, ratios...)
+}
+
+// State returns the current rotation state
+func (a *PayoutAllocator) State() PayoutState {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return PayoutState{append([]string(nil), a.state.Recipients...), a.state.Next}
+}
@@ -0,0 +1,116 @@
+package money
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestPayoutAllocator(t *testing.T) {
+	var saved []PayoutState
+
+	a, err := NewPayoutAllocator(PayoutState{Recipients: []string{"ana", "ben", "cho"}}, func(s PayoutState) error {
+		saved = append(saved, s)
+		return nil
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := [][]int64{{34, 33, 33}, {33, 34, 33}, {33, 33, 34}, {34, 33, 33}}
+
+	for _, e := range expected {
+		parts, err := a.Split(Money{100, "USD"})
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, p := range parts {
+			if p != (Money{e[i], "USD"}) {
+				t.Errorf("Expected %v but got %v", e, parts)
+				break
+			}
+		}
+	}
+
+	if parts, _ := a.Split(Money{200, "USD"}); !reflect.DeepEqual(parts, []Money{{66, "USD"}, {67, "USD"}, {67, "USD"}}) {
+		t.Errorf("Expected ben and cho to absorb the remainder but got %v", parts)
+	}
+
+	if len(saved) != 5 || saved[4].Next != 0 || a.State().Next != 0 {
+		t.Errorf("Expected 5 saved states ending at ana but got %v", saved)
+	}
+
+	if parts, _ := a.Allocate(Money{101, "USD"}, 0, 1, 1); !reflect.DeepEqual(parts, []Money{{0, "USD"}, {51, "USD"}, {50, "USD"}}) {
+		t.Errorf("Expected ben to absorb the remainder but got %v", parts)
+	}
+
+	resumed, _ := NewPayoutAllocator(a.State(), nil)
+
+	if parts, _ := resumed.Split(Money{-100, "USD"}); !reflect.DeepEqual(parts, []Money{{-33, "USD"}, {-33, "USD"}, {-34, "USD"}}) {
+		t.Errorf("Expected cho to absorb the remainder but got %v", parts)
+	}
+}
+
+func TestPayoutAllocatorWhenSaveFails(t *testing.T) {
+	failure := errors.New("disk full")
+	a, _ := NewPayoutAllocator(PayoutState{Recipients: []string{"ana", "ben"}}, func(PayoutState) error { return failure })
+
+	if _, err := a.Split(Money{101, "USD"}); !errors.Is(err, failure) {
+		t.Errorf("Expected the save failure but got %v", err)
+	}
+
+	if a.State().Next != 0 {
+		t.Errorf("Expected the rotation not to advance but got %d", a.State().Next)
+	}
+}
+
+func TestPayoutAllocatorWhenConcurrent(t *testing.T) {
+	a, _ := NewPayoutAllocator(PayoutState{Recipients: []string{"ana", "ben", "cat"}}, nil)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			if i%2 == 0 {
+				a.Split(Money{1, "USD"})
+			} else {
+				a.Allocate(Money{1, "USD"}, 1, 1, 1)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	if next := a.State().Next; next != 1 {
+		t.Errorf("Expected the rotation to advance 100 times but got next %d", next)
+	}
+}
+
+func TestNewPayoutAllocatorWhenInvalid(t *testing.T) {
+	invalid := []PayoutState{
+		{},
+		{Recipients: []string{"ana"}, Next: 1},
+		{Recipients: []string{"ana"}, Next: -1},
+		{Recipients: []string{"ana", "ana"}},
+	}
+
+	for _, s := range invalid {
+		if _, err := NewPayoutAllocator(s, nil); err == nil {
+			t.Errorf("Expected %+v to be rejected", s)
+		}
+	}
+
+	a, _ := NewPayoutAllocator(PayoutState{Recipients: []string{"ana", "ben"}}, nil)
+
+	if _, err := a.Allocate(Money{100, "USD"}, 1); err == nil {
+		t.Error("Expected a ratio per recipient to be required")
+	}
+}
@@ -0,0 +1,186 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// PayConvention selects the days a salary accrues over
+type PayConvention int
+
+const (
+	// CalendarDays accrues over every day, 365 or 366 a year
+	CalendarDays PayConvention = iota
+
+	// WorkingDays accrues over Monday to Friday
+	WorkingDays
+
+	// ThirtyDayMonths accrues over 30 days a month, 360 a year
+	ThirtyDayMonths
+)
+
+// Payroll breaks annual salaries down into monthly pay and daily and hourly
+// rates, and prorates them over partial periods
+type Payroll struct {
+	// Convention is the day count salaries accrue over
+	Convention PayConvention
+
+	// HoursPerWeek is the contractual working week, for hourly rates
+	HoursPerWeek int64
+}
+
+// PayBreakdown is an annual salary broken down by Payroll.Breakdown
+type PayBreakdown struct {
+	Annual Money
+
+	// Months is the pay of each month, adding up to Annual
+	Months [12]Money
+
+	// Daily is the pay per day of the convention, rounded half away from zero
+	Daily Money
+
+	// Hourly is the pay per hour of 52 weeks of HoursPerWeek, rounded half away
+	// from zero
+	Hourly Money
+}
+
+// Breakdown breaks annual down for year. The months share annual by their days
+// under the convention, as Allocate shares by ratios, so they add up to it.
+func (p Payroll) Breakdown(annual Money, year int) (PayBreakdown, error) {
+	if err := p.validate(annual); err != nil {
+		return PayBreakdown{}, err
+	}
+
+	ratios := make([]int, 12)
+	days := int64(0)
+
+	for i := range ratios {
+		ratios[i] = int(p.monthDays(year, time.Month(i+1)))
+		days += int64(ratios[i])
+	}
+
+	months, err := annual.Allocate(ratios...)
+
+	if err != nil {
+		return PayBreakdown{}, err
+	}
+
+	b := PayBreakdown{Annual: annual}
+	copy(b.Months[:], months)
+
+	daily, _ := roundRat(big.NewRat(annual.amount, days))
+	hourly, _ := roundRat(big.NewRat(annual.amount, 52*p.HoursPerWeek))
+	b.Daily, b.Hourly = Money{daily, annual.currency}, Money{hourly, annual.currency}
+
+	return b, nil
+}
+
+// Prorate returns the pay of annual from the day of from up to the day of to,
+// excluded, within one year: each month pays its share of the breakdown for the
+// days worked of it, and the sum is rounded half away from zero once, so that
+// whole months and years prorate to exactly their pay.
+func (p Payroll) Prorate(annual Money, from, to time.Time) (Money, error) {
+	from, to = civilDay(from), civilDay(to)
+
+	if !from.Before(to) || to.AddDate(0, 0, -1).Year() != from.Year() {
+		return Money{}, fmt.Errorf("money: cannot prorate from %s to %s", from.Format("2006-01-02"), to.Format("2006-01-02"))
+	}
+
+	b, err := p.Breakdown(annual, from.Year())
+
+	if err != nil {
+		return Money{}, err
+	}
+
+	pay := new(big.Rat)
+
+	for month := from.Month(); month <= to.AddDate(0, 0, -1).Month(); month++ {
+		start := time.Date(from.Year(), month, 1, 0, 0, 0, 0, time.UTC)
+		end := start.AddDate(0, 1, 0)
+		full := p.monthDays(from.Year(), month)
+
+		if full == 0 {
+			continue
+		}
+
+		worked := full
+
+		if from.After(start) || to.Before(end) {
+			worked = p.days(maxTime(from, start), minTime(to, end))
+		}
+
+		share := new(big.Rat).SetInt64(b.Months[month-1].amount)
+		pay.Add(pay, share.Mul(share, big.NewRat(worked, full)))
+	}
+
+	units, _ := roundRat(pay)
+
+	return Money{units, annual.currency}, nil
+}
+
+// monthDays returns the days month accrues over under the convention
+func (p Payroll) monthDays(year int, month time.Month) int64 {
+	if p.Convention == ThirtyDayMonths {
+		return 30
+	}
+
+	start := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+
+	return p.days(start, start.AddDate(0, 1, 0))
+}
+
+// days returns the days accrued over from the day of from up to to, excluded,
+// within a month
+func (p Payroll) days(from, to time.Time) int64 {
+	var n int64
+
+	for d := from; d.Before(to); d = d.AddDate(0, 0, 1) {
+		if p.Convention != WorkingDays || d.Weekday() != time.Saturday && d.Weekday() != time.Sunday {
+			n++
+		}
+	}
+
+	if p.Convention == ThirtyDayMonths && n > 30 {
+		n = 30
+	}
+
+	return n
+}
+
+// validate fails for invalid conventions, working weeks and negative salaries
+func (p Payroll) validate(annual Money) error {
+	if p.Convention < CalendarDays || p.Convention > ThirtyDayMonths {
+		return fmt.Errorf("money: invalid pay convention %d", p.Convention)
+	}
+
+	if p.HoursPerWeek <= 0 || p.HoursPerWeek > 168 {
+		return fmt.Errorf("money: invalid working week of %d hours", p.HoursPerWeek)
+	}
+
+	if _, ok := currencies[annual.currency]; !ok {
+		return fmt.Errorf("%w %q", ErrUnknownCurrency, annual.currency)
+	}
+
+	if annual.IsNegative() {
+		return fmt.Errorf("money: invalid annual salary %s", annual)
+	}
+
+	return nil
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+
+	return b
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+
+	return b
+}
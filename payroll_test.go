@@ -0,0 +1,94 @@
+package money
+
+import (
+	"testing"
+	"time"
+)
+
+func payDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestPayrollBreakdown(t *testing.T) {
+	annual := Money{6000001, "USD"}
+
+	values := []struct {
+		convention    PayConvention
+		first, second Money
+		daily         Money
+	}{
+		{CalendarDays, Money{508197, "USD"}, Money{475410, "USD"}, Money{16393, "USD"}},
+		{WorkingDays, Money{526718, "USD"}, Money{480917, "USD"}, Money{22901, "USD"}},
+		{ThirtyDayMonths, Money{500001, "USD"}, Money{500000, "USD"}, Money{16667, "USD"}},
+	}
+
+	for _, v := range values {
+		b, err := Payroll{v.convention, 40}.Breakdown(annual, 2024)
+
+		if err != nil || b.Months[0] != v.first || b.Months[1] != v.second || b.Daily != v.daily {
+			t.Errorf("Expected %s, %s and %s a day but got %v %v", v.first, v.second, v.daily, b, err)
+		}
+
+		var total int64
+
+		for _, m := range b.Months {
+			total += m.amount
+		}
+
+		if total != annual.amount {
+			t.Errorf("Expected the months to add up to %s but got %d", annual, total)
+		}
+
+		if b.Hourly != (Money{2885, "USD"}) {
+			t.Errorf("Expected $28.85 an hour but got %s", b.Hourly)
+		}
+	}
+}
+
+func TestPayrollProrate(t *testing.T) {
+	annual := Money{6000001, "USD"}
+
+	values := []struct {
+		convention PayConvention
+		from, to   time.Time
+		expected   Money
+	}{
+		{CalendarDays, payDate(2024, 1, 1), payDate(2025, 1, 1), annual},
+		{WorkingDays, payDate(2024, 1, 1), payDate(2025, 1, 1), annual},
+		{ThirtyDayMonths, payDate(2024, 1, 1), payDate(2025, 1, 1), annual},
+		{CalendarDays, payDate(2024, 2, 1), payDate(2024, 3, 1), Money{475410, "USD"}},
+		{CalendarDays, payDate(2024, 1, 16), payDate(2024, 2, 1), Money{262295, "USD"}},
+		{WorkingDays, payDate(2024, 3, 16), payDate(2024, 3, 18), Money{0, "USD"}},
+		{ThirtyDayMonths, payDate(2024, 2, 15), payDate(2024, 3, 1), Money{250000, "USD"}},
+	}
+
+	for _, v := range values {
+		if pay, err := (Payroll{v.convention, 40}).Prorate(annual, v.from, v.to); err != nil || pay != v.expected {
+			t.Errorf("Expected %s from %s to %s but got %s %v", v.expected, v.from, v.to, pay, err)
+		}
+	}
+}
+
+func TestPayrollWhenInvalid(t *testing.T) {
+	p := Payroll{CalendarDays, 40}
+
+	if _, err := p.Prorate(Money{100, "USD"}, payDate(2024, 3, 1), payDate(2024, 3, 1)); err == nil {
+		t.Error("Expected an empty period to be rejected")
+	}
+
+	if _, err := p.Prorate(Money{100, "USD"}, payDate(2024, 12, 1), payDate(2025, 2, 1)); err == nil {
+		t.Error("Expected a period across years to be rejected")
+	}
+
+	if _, err := p.Breakdown(Money{-100, "USD"}, 2024); err == nil {
+		t.Error("Expected a negative salary to be rejected")
+	}
+
+	if _, err := (Payroll{CalendarDays, 0}).Breakdown(Money{100, "USD"}, 2024); err == nil {
+		t.Error("Expected a working week of 0 hours to be rejected")
+	}
+
+	if _, err := (Payroll{PayConvention(7), 40}).Breakdown(Money{100, "USD"}, 2024); err == nil {
+		t.Error("Expected an invalid convention to be rejected")
+	}
+}
@@ -0,0 +1,187 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// PointsRounding selects how fractional points and values are rounded
+type PointsRounding int
+
+const (
+	// PointsRoundDown truncates toward zero, the usual rule for earning points
+	PointsRoundDown PointsRounding = iota
+
+	// PointsRoundHalfUp rounds half away from zero
+	PointsRoundHalfUp
+
+	// PointsRoundUp rounds away from zero
+	PointsRoundUp
+)
+
+// PointsCurrency describes the points of a rewards program, earned on spend and
+// redeemed as Money in its currency
+type PointsCurrency struct {
+	// Name of the points, e.g. "miles"
+	Name string
+
+	// Currency is the ISO code of the currency points are earned on and redeemed in
+	Currency string
+
+	// EarnRate is the number of points earned per unit of Currency spent
+	EarnRate float64
+
+	// BurnRate is the amount of Currency one point is worth when redeemed
+	BurnRate float64
+
+	// MinimumRedemption is the fewest points that can be redeemed at once
+	MinimumRedemption int64
+
+	// Rounding applies to points earned and to the value of redeemed points
+	Rounding PointsRounding
+
+	// Converter, when set, converts amounts in other currencies to Currency
+	Converter Converter
+}
+
+// Earn returns the points earned on spent, negative for a refund
+func (p PointsCurrency) Earn(spent Money) (int64, error) {
+	if err := p.validate(); err != nil {
+		return 0, err
+	}
+
+	spent, err := p.convert(spent, strings.ToUpper(p.Currency))
+
+	if err != nil {
+		return 0, err
+	}
+
+	r := new(big.Rat).SetFrac64(spent.amount, currencies[spent.currency].units())
+	points, ok := roundPoints(r.Mul(r, decimalRat(p.EarnRate)), p.Rounding)
+
+	if !ok {
+		return 0, fmt.Errorf("%w: %s in %s", ErrOverflow, spent, p.Name)
+	}
+
+	return points, nil
+}
+
+// Value returns the Money in Currency points are redeemed for, failing below the
+// minimum redemption
+func (p PointsCurrency) Value(points int64) (Money, error) {
+	if err := p.validate(); err != nil {
+		return Money{}, err
+	}
+
+	if points < p.MinimumRedemption || points < 0 {
+		return Money{}, fmt.Errorf("money: cannot redeem %d %s, the minimum is %d", points, p.Name, p.MinimumRedemption)
+	}
+
+	code := strings.ToUpper(p.Currency)
+	r := new(big.Rat).SetInt64(points)
+	r.Mul(r, decimalRat(p.BurnRate))
+	amount, ok := roundPoints(r.Mul(r, new(big.Rat).SetInt64(currencies[code].units())), p.Rounding)
+
+	if !ok {
+		return Money{}, fmt.Errorf("%w: %d %s", ErrOverflow, points, p.Name)
+	}
+
+	return Money{amount, code}, nil
+}
+
+// ValueIn returns the value of points converted to the currency to
+func (p PointsCurrency) ValueIn(points int64, to string) (Money, error) {
+	m, err := p.Value(points)
+
+	if err != nil {
+		return Money{}, err
+	}
+
+	return p.convert(m, strings.ToUpper(to))
+}
+
+// PointsFor returns the fewest points whose value covers m
+func (p PointsCurrency) PointsFor(m Money) (int64, error) {
+	if err := p.validate(); err != nil {
+		return 0, err
+	}
+
+	m, err := p.convert(m, strings.ToUpper(p.Currency))
+
+	if err != nil {
+		return 0, err
+	}
+
+	if m.IsNegative() {
+		return 0, fmt.Errorf("money: cannot pay %s with %s", m, p.Name)
+	}
+
+	r := new(big.Rat).SetFrac64(m.amount, currencies[m.currency].units())
+	points, ok := roundPoints(r.Quo(r, decimalRat(p.BurnRate)), PointsRoundUp)
+
+	if !ok {
+		return 0, fmt.Errorf("%w: %s in %s", ErrOverflow, m, p.Name)
+	}
+
+	if points < p.MinimumRedemption {
+		points = p.MinimumRedemption
+	}
+
+	return points, nil
+}
+
+// convert converts m to the currency to with the Converter, when they differ
+func (p PointsCurrency) convert(m Money, to string) (Money, error) {
+	if m.currency == to {
+		return m, nil
+	}
+
+	if p.Converter == nil {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.currency, to)
+	}
+
+	return p.Converter.ConvertMoney(m, to)
+}
+
+// validate fails when the program has an unknown currency or invalid rates
+func (p PointsCurrency) validate() error {
+	code := strings.ToUpper(p.Currency)
+
+	if _, ok := currencies[code]; !ok {
+		return fmt.Errorf("%w %q", ErrUnknownCurrency, p.Currency)
+	}
+
+	for _, rate := range []float64{p.EarnRate, p.BurnRate} {
+		if !(rate > 0) || math.IsInf(rate, 0) {
+			return fmt.Errorf("money: invalid %s rate %v", p.Name, rate)
+		}
+	}
+
+	if p.MinimumRedemption < 0 {
+		return fmt.Errorf("money: invalid %s minimum redemption %d", p.Name, p.MinimumRedemption)
+	}
+
+	return nil
+}
+
+// roundPoints rounds r to an integer by mode, reporting whether it fits in an int64
+func roundPoints(r *big.Rat, mode PointsRounding) (int64, bool) {
+	if mode == PointsRoundHalfUp {
+		return roundRat(r)
+	}
+
+	num, den := new(big.Int).Abs(r.Num()), r.Denom()
+	q, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+
+	if mode == PointsRoundUp && rem.Sign() != 0 {
+		q.Add(q, big.NewInt(1))
+	}
+
+	if r.Sign() < 0 {
+		q.Neg(q)
+	}
+
+	return q.Int64(), q.IsInt64()
+}
@@ -0,0 +1,99 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func samplePoints() PointsCurrency {
+	return PointsCurrency{Name: "points", Currency: "usd", EarnRate: 2, BurnRate: 0.005, MinimumRedemption: 1000}
+}
+
+func TestPointsEarn(t *testing.T) {
+	values := []struct {
+		spent    Money
+		rounding PointsRounding
+		expected int64
+	}{
+		{Money{1099, "USD"}, PointsRoundDown, 21},
+		{Money{1099, "USD"}, PointsRoundHalfUp, 22},
+		{Money{1001, "USD"}, PointsRoundUp, 21},
+		{Money{-1099, "USD"}, PointsRoundDown, -21},
+	}
+
+	for _, v := range values {
+		p := samplePoints()
+		p.Rounding = v.rounding
+
+		points, err := p.Earn(v.spent)
+
+		if err != nil || points != v.expected {
+			t.Errorf("Expected %s to earn %d points but got %d %v", v.spent, v.expected, points, err)
+		}
+	}
+
+	if _, err := samplePoints().Earn(Money{1000, "EUR"}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+}
+
+func TestPointsValue(t *testing.T) {
+	p := samplePoints()
+
+	if m, err := p.Value(1001); err != nil || m != (Money{500, "USD"}) {
+		t.Errorf("Expected $5.00 but got %s %v", m, err)
+	}
+
+	p.Rounding = PointsRoundHalfUp
+
+	if m, err := p.Value(1001); err != nil || m != (Money{501, "USD"}) {
+		t.Errorf("Expected $5.01 but got %s %v", m, err)
+	}
+
+	if _, err := p.Value(999); err == nil {
+		t.Error("Expected redemption below the minimum to fail")
+	}
+}
+
+func TestPointsPointsFor(t *testing.T) {
+	p := samplePoints()
+
+	if points, err := p.PointsFor(Money{1001, "USD"}); err != nil || points != 2002 {
+		t.Errorf("Expected 2002 points but got %d %v", points, err)
+	}
+
+	if points, _ := p.PointsFor(Money{100, "USD"}); points != 1000 {
+		t.Errorf("Expected the minimum of 1000 points but got %d", points)
+	}
+}
+
+func TestPointsConverter(t *testing.T) {
+	e, _ := NewExchange("USD", nil)
+	e.SetRate("USD", "EUR", 0.9)
+
+	p := samplePoints()
+	p.Converter = e
+
+	if points, err := p.Earn(Money{900, "EUR"}); err != nil || points != 20 {
+		t.Errorf("Expected 20 points but got %d %v", points, err)
+	}
+
+	if m, err := p.ValueIn(2000, "eur"); err != nil || m != (Money{900, "EUR"}) {
+		t.Errorf("Expected €9,00 but got %s %v", m, err)
+	}
+}
+
+func TestPointsWhenInvalid(t *testing.T) {
+	invalid := []PointsCurrency{
+		{Currency: "XYZ", EarnRate: 1, BurnRate: 1},
+		{Currency: "USD", EarnRate: 0, BurnRate: 1},
+		{Currency: "USD", EarnRate: 1, BurnRate: -1},
+		{Currency: "USD", EarnRate: 1, BurnRate: 1, MinimumRedemption: -1},
+	}
+
+	for _, p := range invalid {
+		if _, err := p.Earn(Money{100, "USD"}); err == nil {
+			t.Errorf("Expected %+v to be rejected", p)
+		}
+	}
+}
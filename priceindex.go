@@ -0,0 +1,90 @@
+package money
+
+import (
+	"fmt"
+	"sort"
+)
+
+// PriceIndex answers range queries, such as all items between $10 and $25, over
+// many prices of one currency without converting them to floats. It is
+// immutable and safe for concurrent use.
+type PriceIndex struct {
+	currency string
+	amounts  []int64
+	ids      []string
+}
+
+// NewPriceIndex indexes prices by amount, failing when they are in different
+// currencies
+func NewPriceIndex(prices map[string]Money) (*PriceIndex, error) {
+	index := &PriceIndex{amounts: make([]int64, 0, len(prices)), ids: make([]string, 0, len(prices))}
+
+	for id, price := range prices {
+		if index.currency == "" {
+			index.currency = price.currency
+		} else if err := (Money{currency: index.currency}).sameCurrency(price); err != nil {
+			return nil, fmt.Errorf("money: price of %q: %w", id, err)
+		}
+
+		index.ids = append(index.ids, id)
+	}
+
+	// by amount, then by id so equal prices are listed in a stable order
+	sort.Slice(index.ids, func(i, j int) bool {
+		a, b := prices[index.ids[i]].amount, prices[index.ids[j]].amount
+
+		return a < b || a == b && index.ids[i] < index.ids[j]
+	})
+
+	for _, id := range index.ids {
+		index.amounts = append(index.amounts, prices[id].amount)
+	}
+
+	return index, nil
+}
+
+// Len returns the number of prices of the index
+func (index *PriceIndex) Len() int {
+	return len(index.ids)
+}
+
+// Between returns the ids of the prices from low to high inclusive, by price and
+// then by id, failing when low or high is in another currency than the prices
+func (index *PriceIndex) Between(low, high Money) ([]string, error) {
+	i, j, err := index.bounds(low, high)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]string(nil), index.ids[i:j]...), nil
+}
+
+// CountBetween returns the number of prices from low to high inclusive
+func (index *PriceIndex) CountBetween(low, high Money) (int, error) {
+	i, j, err := index.bounds(low, high)
+
+	return j - i, err
+}
+
+// bounds returns the range of the sorted prices from low to high inclusive
+func (index *PriceIndex) bounds(low, high Money) (int, int, error) {
+	if len(index.ids) == 0 {
+		return 0, 0, nil
+	}
+
+	for _, m := range []Money{low, high} {
+		if err := (Money{currency: index.currency}).sameCurrency(m); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	i := sort.Search(len(index.amounts), func(k int) bool { return index.amounts[k] >= low.amount })
+	j := sort.Search(len(index.amounts), func(k int) bool { return index.amounts[k] > high.amount })
+
+	if j < i {
+		j = i
+	}
+
+	return i, j, nil
+}
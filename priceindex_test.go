@@ -0,0 +1,61 @@
+package money
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestPriceIndex(t *testing.T) {
+	index, err := NewPriceIndex(map[string]Money{
+		"mug":    {999, "USD"},
+		"shirt":  {2500, "USD"},
+		"cap":    {1000, "USD"},
+		"poster": {1000, "USD"},
+		"hoodie": {4500, "USD"},
+		"pin":    {250, "USD"},
+	})
+
+	if err != nil || index.Len() != 6 {
+		t.Fatalf("Expected an index of 6 prices but got %v", err)
+	}
+
+	values := []struct {
+		min, max int64
+		expected []string
+	}{
+		{1000, 2500, []string{"cap", "poster", "shirt"}},
+		{0, 999, []string{"pin", "mug"}},
+		{4501, 9999, nil},
+		{2500, 1000, nil},
+		{-100, 100000, []string{"pin", "mug", "cap", "poster", "shirt", "hoodie"}},
+	}
+
+	for _, v := range values {
+		ids, err := index.Between(Money{v.min, "USD"}, Money{v.max, "USD"})
+
+		if err != nil || len(ids) != len(v.expected) || len(ids) > 0 && !reflect.DeepEqual(ids, v.expected) {
+			t.Errorf("Expected %v between %d and %d but got %v %v", v.expected, v.min, v.max, ids, err)
+		}
+
+		if n, err := index.CountBetween(Money{v.min, "USD"}, Money{v.max, "USD"}); err != nil || n != len(v.expected) {
+			t.Errorf("Expected %d between %d and %d but got %d %v", len(v.expected), v.min, v.max, n, err)
+		}
+	}
+
+	if _, err := index.Between(Money{0, "EUR"}, Money{100, "EUR"}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+}
+
+func TestNewPriceIndexWhenInvalid(t *testing.T) {
+	if _, err := NewPriceIndex(map[string]Money{"a": {1, "USD"}, "b": {1, "EUR"}}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+
+	index, err := NewPriceIndex(nil)
+
+	if ids, _ := index.Between(Money{0, "USD"}, Money{1, "USD"}); err != nil || len(ids) != 0 {
+		t.Errorf("Expected an empty index but got %v %v", ids, err)
+	}
+}
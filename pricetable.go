@@ -0,0 +1,190 @@
+package money
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+)
+
+// A price table file maps ids to Money and is read in place, e.g. memory mapped.
+// All integers are little endian:
+//
+//	header   magic "MNYP", version uint16, reserved uint16, count uint32, reserved uint32
+//	entries  count times: amount int64, id offset uint32, id length uint16,
+//	         currency code [3]byte, reserved [7]byte; sorted by id
+//	ids      the id bytes the entries point into, relative to the start of ids
+const (
+	priceTableMagic   = "MNYP"
+	priceTableVersion = 1
+	priceHeaderSize   = 16
+	priceEntrySize    = 24
+)
+
+// PriceTable is a read only table of prices by id, backed by a price table file
+type PriceTable struct {
+	data  []byte
+	count int
+	ids   []byte
+	close func() error
+}
+
+// WritePriceTable writes prices in the price table file format read by
+// OpenPriceTable and NewPriceTable
+func WritePriceTable(w io.Writer, prices map[string]Money) error {
+	ids := make([]string, 0, len(prices))
+	size := 0
+
+	for id, m := range prices {
+		if len(id) > math.MaxUint16 {
+			return fmt.Errorf("money: price id %.16q... is longer than %d bytes", id, math.MaxUint16)
+		}
+
+		if _, ok := currencies[m.currency]; !ok {
+			return fmt.Errorf("%w %q for price %q", ErrUnknownCurrency, m.currency, id)
+		}
+
+		ids = append(ids, id)
+		size += len(id)
+	}
+
+	if uint64(len(ids)) > math.MaxUint32 || uint64(size) > math.MaxUint32 {
+		return fmt.Errorf("money: too many prices for a price table")
+	}
+
+	sort.Strings(ids)
+
+	b := bufio.NewWriter(w)
+	header := make([]byte, priceHeaderSize)
+	copy(header, priceTableMagic)
+	binary.LittleEndian.PutUint16(header[4:], priceTableVersion)
+	binary.LittleEndian.PutUint32(header[8:], uint32(len(ids)))
+	b.Write(header)
+
+	entry := make([]byte, priceEntrySize)
+	offset := 0
+
+	for _, id := range ids {
+		m := prices[id]
+		binary.LittleEndian.PutUint64(entry, uint64(m.amount))
+		binary.LittleEndian.PutUint32(entry[8:], uint32(offset))
+		binary.LittleEndian.PutUint16(entry[12:], uint16(len(id)))
+		copy(entry[14:17], m.currency)
+		b.Write(entry)
+
+		offset += len(id)
+	}
+
+	for _, id := range ids {
+		b.WriteString(id)
+	}
+
+	return b.Flush()
+}
+
+// NewPriceTable returns the PriceTable held by data, in the price table file
+// format. The table uses data without copying, and only the header is checked
+// so that opening takes the same time whatever the size of the table; entries
+// are checked as Lookup reads them.
+func NewPriceTable(data []byte) (*PriceTable, error) {
+	if len(data) < priceHeaderSize || string(data[:4]) != priceTableMagic {
+		return nil, invalidPriceTable("missing header")
+	}
+
+	if version := binary.LittleEndian.Uint16(data[4:]); version != priceTableVersion {
+		return nil, invalidPriceTable("unsupported version %d", version)
+	}
+
+	count := uint64(binary.LittleEndian.Uint32(data[8:]))
+	end := priceHeaderSize + count*priceEntrySize
+
+	if end > uint64(len(data)) {
+		return nil, invalidPriceTable("%d entries exceed %d bytes", count, len(data))
+	}
+
+	return &PriceTable{data: data, count: int(count), ids: data[end:]}, nil
+}
+
+// Len returns the number of prices
+func (t *PriceTable) Len() int {
+	return t.count
+}
+
+// Lookup returns the price of id, failing with ErrNoPrice when the table has
+// none. The ids are not checked to be sorted as a whole: Lookup fails instead
+// when two of the entries its binary search visits are out of order, and when
+// the entry found points past the ids or has an unknown currency.
+func (t *PriceTable) Lookup(id string) (Money, error) {
+	lo, hi := 0, t.count
+	var low, high []byte
+	var hasLow, hasHigh bool
+
+	for lo < hi {
+		i := int(uint(lo+hi) >> 1)
+		probe, err := t.id(i)
+
+		if err != nil {
+			return Money{}, err
+		}
+
+		if hasLow && string(probe) <= string(low) || hasHigh && string(probe) >= string(high) {
+			return Money{}, invalidPriceTable("entry %d is out of order", i)
+		}
+
+		if string(probe) < id {
+			lo, low, hasLow = i+1, probe, true
+		} else {
+			hi, high, hasHigh = i, probe, true
+		}
+	}
+
+	if !hasHigh || string(high) != id {
+		return Money{}, fmt.Errorf("%w for %q", ErrNoPrice, id)
+	}
+
+	e := t.entry(hi)
+	code := e[14:17]
+
+	if _, ok := currencies[string(code)]; !ok {
+		return Money{}, invalidPriceTable("entry %d has unknown currency %q", hi, code)
+	}
+
+	return Money{int64(binary.LittleEndian.Uint64(e)), string(code)}, nil
+}
+
+// Close releases the file backing the table, which must not be used afterwards
+func (t *PriceTable) Close() error {
+	if t.close == nil {
+		return nil
+	}
+
+	release := t.close
+	t.close = nil
+
+	return release()
+}
+
+// entry returns the bytes of entry i
+func (t *PriceTable) entry(i int) []byte {
+	start := priceHeaderSize + i*priceEntrySize
+	return t.data[start : start+priceEntrySize]
+}
+
+// id returns the id bytes of entry i, failing when they lie past the ids
+func (t *PriceTable) id(i int) ([]byte, error) {
+	e := t.entry(i)
+	offset, length := uint64(binary.LittleEndian.Uint32(e[8:])), uint64(binary.LittleEndian.Uint16(e[12:]))
+
+	if offset+length > uint64(len(t.ids)) {
+		return nil, invalidPriceTable("entry %d points past the ids", i)
+	}
+
+	return t.ids[offset : offset+length], nil
+}
+
+// invalidPriceTable returns an error describing a malformed price table
+func invalidPriceTable(msg string, args ...interface{}) error {
+	return fmt.Errorf("money: invalid price table: "+msg, args...)
+}
@@ -0,0 +1,19 @@
+//go:build !unix
+
+package money
+
+import (
+	"os"
+)
+
+// OpenPriceTable reads the price table file at path into memory, as memory
+// mapping is only used on unix systems
+func OpenPriceTable(path string) (*PriceTable, error) {
+	data, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPriceTable(data)
+}
@@ -0,0 +1,105 @@
+package money
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func samplePrices() map[string]Money {
+	return map[string]Money{
+		"sku-1":   {1999, "USD"},
+		"sku-2":   {-500, "EUR"},
+		"sku-10":  {1234, "JPY"},
+		"":        {0, "USD"},
+		"ä-ünïcø": {1, "BHD"},
+	}
+}
+
+func TestPriceTable(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prices.bin")
+	f, err := os.Create(path)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := WritePriceTable(f, samplePrices()); err != nil {
+		t.Fatal(err)
+	}
+
+	f.Close()
+
+	table, err := OpenPriceTable(path)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer table.Close()
+
+	if table.Len() != len(samplePrices()) {
+		t.Errorf("Expected %d prices but got %d", len(samplePrices()), table.Len())
+	}
+
+	for id, expected := range samplePrices() {
+		if m, err := table.Lookup(id); err != nil || m != expected {
+			t.Errorf("Expected %q to cost %s but got %s (%v)", id, expected, m, err)
+		}
+	}
+
+	if _, err := table.Lookup("sku-3"); !errors.Is(err, ErrNoPrice) {
+		t.Errorf("Expected ErrNoPrice but got %v", err)
+	}
+}
+
+func TestPriceTableWhenInvalid(t *testing.T) {
+	var buf bytes.Buffer
+	WritePriceTable(&buf, samplePrices())
+	data := buf.Bytes()
+
+	corrupt := map[string][]byte{
+		"empty":     nil,
+		"magic":     append([]byte("XXXX"), data[4:]...),
+		"version":   append(append([]byte{}, data[:4]...), append([]byte{9, 0}, data[6:]...)...),
+		"truncated": data[:priceHeaderSize+priceEntrySize],
+	}
+
+	for name, data := range corrupt {
+		if _, err := NewPriceTable(data); err == nil {
+			t.Errorf("Expected %s price table to be rejected", name)
+		}
+	}
+
+	// the entries are sorted by id, so the first one is the empty id
+	currency := append([]byte{}, data...)
+	copy(currency[priceHeaderSize+14:], "XYZ")
+
+	offset := append([]byte{}, data...)
+	binary.LittleEndian.PutUint32(offset[priceHeaderSize+8:], 1000)
+
+	order := append([]byte{}, data...)
+	first, last := order[priceHeaderSize:priceHeaderSize+priceEntrySize], order[priceHeaderSize+4*priceEntrySize:priceHeaderSize+5*priceEntrySize]
+	swapped := append([]byte{}, first...)
+	copy(first, last)
+	copy(last, swapped)
+
+	for name, data := range map[string][]byte{"currency": currency, "offset": offset, "order": order} {
+		table, err := NewPriceTable(data)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := table.Lookup(""); err == nil || errors.Is(err, ErrNoPrice) {
+			t.Errorf("Expected the %s price table lookup to be rejected but got %v", name, err)
+		}
+	}
+
+	if err := WritePriceTable(&buf, map[string]Money{"sku": {}}); err == nil {
+		t.Error("Expected a price without currency to be rejected")
+	}
+}
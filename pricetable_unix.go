@@ -0,0 +1,47 @@
+//go:build unix
+
+package money
+
+import (
+	"os"
+	"syscall"
+)
+
+// OpenPriceTable memory maps the price table file at path, so prices are read
+// from disk on demand. Close unmaps the file.
+func OpenPriceTable(path string) (*PriceTable, error) {
+	f, err := os.Open(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	info, err := f.Stat()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if info.Size() == 0 {
+		return NewPriceTable(nil)
+	}
+
+	data, err := syscall.Mmap(int(f.Fd()), 0, int(info.Size()), syscall.PROT_READ, syscall.MAP_SHARED)
+
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := NewPriceTable(data)
+
+	if err != nil {
+		syscall.Munmap(data)
+		return nil, err
+	}
+
+	t.close = func() error { return syscall.Munmap(data) }
+
+	return t, nil
+}
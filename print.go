@@ -0,0 +1,43 @@
+package money
+
+import (
+	"strings"
+)
+
+const (
+	figureSpace        = "\u2007"
+	narrowNoBreakSpace = "\u202f"
+)
+
+// FormatPrint returns a formatted price string for print output such as PDFs and
+// invoices: spaces between groups, symbol and currency code become narrow
+// no-break spaces, so an amount never wraps across lines
+func FormatPrint(val float64, opts ...Options) string {
+	noteDeprecated(opts...)
+
+	return strings.Replace(Format(val, opts...), " ", narrowNoBreakSpace, -1)
+}
+
+// ColumnWidth returns the display width of the widest formatted amount
+func ColumnWidth(column []string) (width int) {
+	for _, s := range column {
+		if w := DisplayWidth(s); w > width {
+			width = w
+		}
+	}
+
+	return width
+}
+
+// PadColumn right aligns formatted amounts by left padding them with figure spaces,
+// which have the width of a digit in fonts with tabular figures
+func PadColumn(column []string) []string {
+	width := ColumnWidth(column)
+	padded := make([]string, len(column))
+
+	for i, s := range column {
+		padded[i] = strings.Repeat(figureSpace, width-DisplayWidth(s)) + s
+	}
+
+	return padded
+}
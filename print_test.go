@@ -0,0 +1,43 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestFormatPrint(t *testing.T) {
+	currency := FormatPrint(1234.5, Options{"currency": "SEK", "with_currency": true})
+	expected := "1\u202f234,50kr\u202fSEK"
+
+	if currency != expected {
+		t.Errorf("Expected %q but got %q", expected, currency)
+	}
+
+	if currency := FormatPrint(10, Options{"with_symbol_space": true}); currency != "$\u202f10.00" {
+		t.Errorf("Expected narrow no-break symbol space but got %q", currency)
+	}
+}
+
+func TestColumnWidth(t *testing.T) {
+	if w := ColumnWidth([]string{"€1,00", "€1.234,00", "€10,00"}); w != 9 {
+		t.Errorf("Expected width 9 but got %d", w)
+	}
+
+	if w := ColumnWidth([]string{"1,000円", "¥1,000"}); w != 7 {
+		t.Errorf("Expected width 7 but got %d", w)
+	}
+
+	if w := ColumnWidth(nil); w != 0 {
+		t.Errorf("Expected width 0 but got %d", w)
+	}
+}
+
+func TestPadColumn(t *testing.T) {
+	padded := PadColumn([]string{"$1.00", "$1,234.00", "$10.00"})
+	expected := []string{"\u2007\u2007\u2007\u2007$1.00", "$1,234.00", "\u2007\u2007\u2007$10.00"}
+
+	for i := range expected {
+		if padded[i] != expected[i] {
+			t.Errorf("Expected %q but got %q", expected[i], padded[i])
+		}
+	}
+}
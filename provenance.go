@@ -0,0 +1,59 @@
+package money
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// registrySource names the data set the currency registry was derived from
+const registrySource = "Ruby money gem currency tables"
+
+// Provenance describes where a currency registry record came from, for
+// compliance reviews and data refresh tooling. The package records no ISO 4217
+// amendment date or CLDR release for its data, so until one is verified those
+// fields are empty rather than guessed.
+type Provenance struct {
+	// Standard is "ISO 4217" for currencies with an ISO numeric code, empty
+	// for others such as BTC
+	Standard string
+
+	// Source names the data set the record was derived from
+	Source string
+
+	// ISOPublication is the date of the ISO 4217 amendment the record follows
+	ISOPublication string
+
+	// CLDRVersion is the CLDR release the symbol and separators follow
+	CLDRVersion string
+
+	// Checksum is the SHA-256 checksum, in hex, of the record, to tell whether
+	// it changed since a review
+	Checksum string
+}
+
+// CurrencyProvenance returns the provenance of the registry record of the currency
+func CurrencyProvenance(code string) (Provenance, error) {
+	code = strings.ToUpper(code)
+	c, ok := currencies[code]
+
+	if !ok {
+		return Provenance{}, fmt.Errorf("%w %q", ErrUnknownCurrency, code)
+	}
+
+	p := Provenance{Source: registrySource, Checksum: recordChecksum(code, c)}
+
+	if c.IsoNumeric > 0 {
+		p.Standard = "ISO 4217"
+	}
+
+	return p, nil
+}
+
+// recordChecksum returns the checksum of a registry record, as hashed by
+// DatasetChecksum
+func recordChecksum(code string, c currency) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("currency %s %#v\n", code, c)))
+	return hex.EncodeToString(sum[:])
+}
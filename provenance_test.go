@@ -0,0 +1,30 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCurrencyProvenance(t *testing.T) {
+	p, err := CurrencyProvenance("eur")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p.Standard != "ISO 4217" || p.Source == "" || p.ISOPublication != "" || p.CLDRVersion != "" || len(p.Checksum) != 64 {
+		t.Errorf("Expected an ISO 4217 record without verified dates but got %+v", p)
+	}
+
+	if btc, _ := CurrencyProvenance("BTC"); btc.Standard != "" {
+		t.Errorf("Expected BTC to follow no standard but got %s", btc.Standard)
+	}
+
+	if usd, _ := CurrencyProvenance("USD"); usd.Checksum == p.Checksum {
+		t.Error("Expected records to have distinct checksums")
+	}
+
+	if _, err := CurrencyProvenance("XYZ"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+}
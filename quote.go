@@ -0,0 +1,125 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// QuoteSide selects the bid or the ask of a Quote
+type QuoteSide int
+
+const (
+	// Bid is the price a dealer buys at
+	Bid QuoteSide = iota
+
+	// Ask is the price a dealer sells at
+	Ask
+)
+
+// Quote is a two-sided price, e.g. of one unit of a foreign currency
+type Quote struct {
+	Bid Money
+	Ask Money
+}
+
+// Mid returns the midpoint of the bid and the ask, rounded half away from zero
+// to minor units
+func (q Quote) Mid() (Money, error) {
+	if err := q.validate(); err != nil {
+		return Money{}, err
+	}
+
+	sum := new(big.Int).Add(big.NewInt(q.Bid.amount), big.NewInt(q.Ask.amount))
+	units, _ := roundRat(new(big.Rat).SetFrac(sum, big.NewInt(2)))
+
+	return Money{units, q.Bid.currency}, nil
+}
+
+// Spread returns the ask less the bid
+func (q Quote) Spread() (Money, error) {
+	if err := q.validate(); err != nil {
+		return Money{}, err
+	}
+
+	return q.Ask.Subtract(q.Bid)
+}
+
+// SpreadBps returns the spread in basis points of the exact midpoint, failing
+// when the midpoint is zero
+func (q Quote) SpreadBps() (float64, error) {
+	if err := q.validate(); err != nil {
+		return 0, err
+	}
+
+	sum := new(big.Int).Add(big.NewInt(q.Bid.amount), big.NewInt(q.Ask.amount))
+
+	if sum.Sign() == 0 {
+		return 0, fmt.Errorf("money: spread of %s has no midpoint", q)
+	}
+
+	// (ask - bid) / ((bid + ask) / 2) * 10000
+	spread := new(big.Int).Sub(big.NewInt(q.Ask.amount), big.NewInt(q.Bid.amount))
+	bps, _ := new(big.Rat).SetFrac(spread.Mul(spread, big.NewInt(20000)), sum).Float64()
+
+	return bps, nil
+}
+
+// WithMargin returns q with one side moved away from the other by bps basis
+// points of its price, rounded half away from zero to minor units: the bid is
+// lowered and the ask raised, widening the spread by the margin
+func (q Quote) WithMargin(side QuoteSide, bps float64) (Quote, error) {
+	if err := q.validate(); err != nil {
+		return Quote{}, err
+	}
+
+	if !(bps >= 0) || math.IsInf(bps, 0) {
+		return Quote{}, fmt.Errorf("money: invalid margin %v bps", bps)
+	}
+
+	factor := new(big.Rat).Quo(decimalRat(bps), big.NewRat(10000, 1))
+
+	switch side {
+	case Bid:
+		factor.Sub(big.NewRat(1, 1), factor)
+	case Ask:
+		factor.Add(big.NewRat(1, 1), factor)
+	default:
+		return Quote{}, fmt.Errorf("money: invalid quote side %d", side)
+	}
+
+	price := &q.Bid
+
+	if side == Ask {
+		price = &q.Ask
+	}
+
+	units, ok := roundRat(factor.Mul(factor, new(big.Rat).SetInt64(price.amount)))
+
+	if !ok {
+		return Quote{}, fmt.Errorf("%w: %s with a margin of %v bps", ErrOverflow, *price, bps)
+	}
+
+	price.amount = units
+
+	return q, nil
+}
+
+// String returns the bid and the ask, e.g. "$1.08/$1.09"
+func (q Quote) String() string {
+	return q.Bid.String() + "/" + q.Ask.String()
+}
+
+// validate ensures the bid and the ask share a currency and the bid does not
+// exceed the ask
+func (q Quote) validate() error {
+	if err := q.Bid.sameCurrency(q.Ask); err != nil {
+		return err
+	}
+
+	if q.Bid.amount > q.Ask.amount {
+		return fmt.Errorf("money: quote bid %s exceeds ask %s", q.Bid, q.Ask)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestQuote(t *testing.T) {
+	q := Quote{Money{10850, "USD"}, Money{10860, "USD"}}
+
+	if mid, err := q.Mid(); err != nil || mid != (Money{10855, "USD"}) {
+		t.Errorf("Expected $108.55 but got %s %v", mid, err)
+	}
+
+	if mid, _ := (Quote{Money{10850, "USD"}, Money{10851, "USD"}}).Mid(); mid != (Money{10851, "USD"}) {
+		t.Errorf("Expected the midpoint to round half away from zero but got %s", mid)
+	}
+
+	if spread, err := q.Spread(); err != nil || spread != (Money{10, "USD"}) {
+		t.Errorf("Expected $0.10 but got %s %v", spread, err)
+	}
+
+	if bps, err := q.SpreadBps(); err != nil || math.Abs(bps-9.2123) > 0.0001 {
+		t.Errorf("Expected 9.2123 bps but got %v %v", bps, err)
+	}
+
+	if s := q.String(); s != "$108.50/$108.60" {
+		t.Errorf("Expected $108.50/$108.60 but got %s", s)
+	}
+}
+
+func TestQuoteWithMargin(t *testing.T) {
+	q := Quote{Money{10850, "USD"}, Money{10860, "USD"}}
+
+	values := []struct {
+		side     QuoteSide
+		bps      float64
+		expected Quote
+	}{
+		{Ask, 25, Quote{Money{10850, "USD"}, Money{10887, "USD"}}},
+		{Bid, 25, Quote{Money{10823, "USD"}, Money{10860, "USD"}}},
+		{Bid, 0, q},
+	}
+
+	for _, v := range values {
+		if got, err := q.WithMargin(v.side, v.bps); err != nil || got != v.expected {
+			t.Errorf("Expected %s but got %s %v", v.expected, got, err)
+		}
+	}
+
+	for _, bps := range []float64{-1, math.NaN(), math.Inf(1)} {
+		if _, err := q.WithMargin(Ask, bps); err == nil {
+			t.Errorf("Expected a margin of %v bps to be rejected", bps)
+		}
+	}
+
+	if _, err := q.WithMargin(QuoteSide(2), 1); err == nil {
+		t.Error("Expected an unknown side to be rejected")
+	}
+}
+
+func TestQuoteWhenInvalid(t *testing.T) {
+	if _, err := (Quote{Money{100, "USD"}, Money{100, "EUR"}}).Mid(); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+
+	if _, err := (Quote{Money{101, "USD"}, Money{100, "USD"}}).Spread(); err == nil {
+		t.Error("Expected a crossed quote to be rejected")
+	}
+
+	if _, err := (Quote{Money{0, "USD"}, Money{0, "USD"}}).SpreadBps(); err == nil {
+		t.Error("Expected a zero midpoint to be rejected")
+	}
+}
@@ -0,0 +1,340 @@
+package money
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateCache stores fetched exchange rates, e.g. in a file or in Redis, so
+// replicas share them and keep them across restarts
+type RateCache interface {
+	// Get returns the cached rate of pair, reporting whether there is one which
+	// has not expired
+	Get(ctx context.Context, pair Pair) (rate float64, found bool, err error)
+
+	// Set caches the rate of pair for ttl
+	Set(ctx context.Context, pair Pair, rate float64, ttl time.Duration) error
+}
+
+// CachedProvider is a RateProvider answering from a RateCache, falling back to
+// its provider and caching the rates it returns. Lookups are reported to the
+// installed Metrics as the "rates" cache. It is safe for concurrent use when its
+// provider and cache are.
+type CachedProvider struct {
+	provider RateProvider
+	cache    RateCache
+	ttl      time.Duration
+}
+
+// NewCachedProvider returns a CachedProvider caching the rates of provider in
+// cache for ttl, failing when ttl is not positive
+func NewCachedProvider(provider RateProvider, cache RateCache, ttl time.Duration) (*CachedProvider, error) {
+	if ttl <= 0 {
+		return nil, fmt.Errorf("money: invalid rate cache ttl %s", ttl)
+	}
+
+	return &CachedProvider{provider, cache, ttl}, nil
+}
+
+// Rate is RateContext with a background context
+func (p *CachedProvider) Rate(from, to string) (float64, error) {
+	return p.RateContext(context.Background(), from, to)
+}
+
+// RateContext returns the cached rate of from/to, or fetches and caches it,
+// passing ctx on to the cache and to a ContextRateProvider. It fails when the
+// cache does, so a broken cache is noticed rather than bypassed.
+func (p *CachedProvider) RateContext(ctx context.Context, from, to string) (float64, error) {
+	pair := Pair{from, to}
+	rate, found, err := p.cache.Get(ctx, pair)
+
+	if err != nil {
+		return 0, err
+	}
+
+	observeCacheLookup("rates", found)
+
+	if found {
+		return rate, nil
+	}
+
+	if cp, ok := p.provider.(ContextRateProvider); ok {
+		rate, err = cp.RateContext(ctx, from, to)
+	} else {
+		rate, err = p.provider.Rate(from, to)
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	if err := p.cache.Set(ctx, pair, rate, p.ttl); err != nil {
+		return 0, err
+	}
+
+	return rate, nil
+}
+
+// cachedRate is a rate of a MemoryRateCache or a FileRateCache
+type cachedRate struct {
+	Rate    float64   `json:"rate"`
+	Expires time.Time `json:"expires"`
+}
+
+// MemoryRateCache is a RateCache in memory, e.g. for a single process or tests.
+// It is safe for concurrent use.
+type MemoryRateCache struct {
+	mu    sync.Mutex
+	rates map[Pair]cachedRate
+	now   func() time.Time
+}
+
+// NewMemoryRateCache returns an empty MemoryRateCache
+func NewMemoryRateCache() *MemoryRateCache {
+	return &MemoryRateCache{rates: map[Pair]cachedRate{}, now: time.Now}
+}
+
+// Get returns the rate of pair unless it has expired
+func (c *MemoryRateCache) Get(_ context.Context, pair Pair) (float64, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	r, ok := c.rates[pair]
+
+	if !ok || !c.now().Before(r.Expires) {
+		return 0, false, nil
+	}
+
+	return r.Rate, true, nil
+}
+
+// Set caches the rate of pair for ttl
+func (c *MemoryRateCache) Set(_ context.Context, pair Pair, rate float64, ttl time.Duration) error {
+	if err := checkCachedRate(pair, rate); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.rates[pair] = cachedRate{rate, c.now().Add(ttl)}
+
+	return nil
+}
+
+// FileRateCache is a RateCache in a JSON file, e.g. on a volume shared by
+// replicas. Set replaces the file atomically, keeping the unexpired rates, and
+// Get rereads it when it has changed. It is safe for concurrent use within a
+// process; processes writing the same file at once may lose each other's rates.
+type FileRateCache struct {
+	path string
+
+	mu       sync.Mutex
+	rates    map[string]cachedRate
+	modified time.Time
+	now      func() time.Time
+}
+
+// NewFileRateCache returns a FileRateCache at path, which need not exist yet,
+// failing when it cannot be read or is not a rate cache
+func NewFileRateCache(path string) (*FileRateCache, error) {
+	c := &FileRateCache{path: path, rates: map[string]cachedRate{}, now: time.Now}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.reload(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Get returns the rate of pair unless it has expired
+func (c *FileRateCache) Get(_ context.Context, pair Pair) (float64, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.reload(); err != nil {
+		return 0, false, err
+	}
+
+	r, ok := c.rates[pair.String()]
+
+	if !ok || !c.now().Before(r.Expires) {
+		return 0, false, nil
+	}
+
+	return r.Rate, true, nil
+}
+
+// Set caches the rate of pair for ttl and writes the file
+func (c *FileRateCache) Set(_ context.Context, pair Pair, rate float64, ttl time.Duration) error {
+	if err := checkCachedRate(pair, rate); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.reload(); err != nil {
+		return err
+	}
+
+	now := c.now()
+	rates := map[string]cachedRate{pair.String(): {rate, now.Add(ttl)}}
+
+	for key, r := range c.rates {
+		if _, ok := rates[key]; !ok && now.Before(r.Expires) {
+			rates[key] = r
+		}
+	}
+
+	data, err := json.MarshalIndent(rates, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	// written next to the cache then renamed over it, so readers never see a
+	// partial file
+	tmp, err := os.CreateTemp(filepath.Dir(c.path), filepath.Base(c.path)+".*")
+
+	if err != nil {
+		return fmt.Errorf("money: cannot write rate cache: %w", err)
+	}
+
+	_, err = tmp.Write(data)
+
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		err = os.Rename(tmp.Name(), c.path)
+	}
+
+	if err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("money: cannot write rate cache: %w", err)
+	}
+
+	c.rates = rates
+
+	if info, err := os.Stat(c.path); err == nil {
+		c.modified = info.ModTime()
+	}
+
+	return nil
+}
+
+// reload reads the file when it has changed since it was last read
+func (c *FileRateCache) reload() error {
+	info, err := os.Stat(c.path)
+
+	if errors.Is(err, os.ErrNotExist) {
+		c.rates, c.modified = map[string]cachedRate{}, time.Time{}
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("money: cannot read rate cache: %w", err)
+	}
+
+	if info.ModTime().Equal(c.modified) {
+		return nil
+	}
+
+	data, err := os.ReadFile(c.path)
+
+	if err != nil {
+		return fmt.Errorf("money: cannot read rate cache: %w", err)
+	}
+
+	rates := map[string]cachedRate{}
+
+	if err := json.Unmarshal(data, &rates); err != nil {
+		return fmt.Errorf("money: invalid rate cache %s: %s", c.path, err)
+	}
+
+	c.rates, c.modified = rates, info.ModTime()
+
+	return nil
+}
+
+// RedisClient is the part of a Redis client a RedisRateCache uses, adapted in a
+// few lines from clients such as go-redis:
+//
+//	func (c adapter) Get(ctx context.Context, key string) (string, bool, error) {
+//		v, err := c.Client.Get(ctx, key).Result()
+//		if err == redis.Nil {
+//			return "", false, nil
+//		}
+//		return v, err == nil, err
+//	}
+//
+//	func (c adapter) Set(ctx context.Context, key, value string, ttl time.Duration) error {
+//		return c.Client.Set(ctx, key, value, ttl).Err()
+//	}
+type RedisClient interface {
+	// Get returns the value of key, reporting whether it exists
+	Get(ctx context.Context, key string) (value string, found bool, err error)
+
+	// Set sets key to value, expiring after ttl
+	Set(ctx context.Context, key, value string, ttl time.Duration) error
+}
+
+// RedisRateCache is a RateCache in Redis, keeping each rate under its pair
+// prefixed by prefix, e.g. "rates:EUR/USD", expired by Redis
+type RedisRateCache struct {
+	client RedisClient
+	prefix string
+}
+
+// NewRedisRateCache returns a RedisRateCache over client
+func NewRedisRateCache(client RedisClient, prefix string) *RedisRateCache {
+	return &RedisRateCache{client, prefix}
+}
+
+// Get returns the rate of pair, failing when the value is not a rate
+func (c *RedisRateCache) Get(ctx context.Context, pair Pair) (float64, bool, error) {
+	value, found, err := c.client.Get(ctx, c.prefix+pair.String())
+
+	if err != nil || !found {
+		return 0, false, err
+	}
+
+	rate, err := strconv.ParseFloat(value, 64)
+
+	if err != nil || checkCachedRate(pair, rate) != nil {
+		return 0, false, fmt.Errorf("money: invalid cached rate %q for %s", value, pair)
+	}
+
+	return rate, true, nil
+}
+
+// Set caches the rate of pair for ttl
+func (c *RedisRateCache) Set(ctx context.Context, pair Pair, rate float64, ttl time.Duration) error {
+	if err := checkCachedRate(pair, rate); err != nil {
+		return err
+	}
+
+	return c.client.Set(ctx, c.prefix+pair.String(), strconv.FormatFloat(rate, 'g', -1, 64), ttl)
+}
+
+// checkCachedRate ensures rate is a valid exchange rate
+func checkCachedRate(pair Pair, rate float64) error {
+	if !(rate > 0) || math.IsInf(rate, 0) {
+		return fmt.Errorf("money: invalid exchange rate %v for %s", rate, pair)
+	}
+
+	return nil
+}
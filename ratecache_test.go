@@ -0,0 +1,149 @@
+package money
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// fakeRedis is a RedisClient over a map, ignoring expiry
+type fakeRedis map[string]string
+
+func (r fakeRedis) Get(_ context.Context, key string) (string, bool, error) {
+	v, ok := r[key]
+	return v, ok, nil
+}
+
+func (r fakeRedis) Set(_ context.Context, key, value string, _ time.Duration) error {
+	r[key] = value
+	return nil
+}
+
+func TestCachedProvider(t *testing.T) {
+	calls := 0
+	provider := RateProviderFunc(func(from, to string) (float64, error) {
+		calls++
+		return 1.08, nil
+	})
+
+	cache := NewMemoryRateCache()
+	clock := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return clock }
+
+	p, err := NewCachedProvider(provider, cache, time.Minute)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if rate, err := p.Rate("EUR", "USD"); err != nil || rate != 1.08 {
+			t.Errorf("Expected 1.08 but got %v %v", rate, err)
+		}
+	}
+
+	clock = clock.Add(time.Minute)
+	p.Rate("EUR", "USD")
+
+	if calls != 2 {
+		t.Errorf("Expected a fetch per ttl but got %d", calls)
+	}
+
+	if _, err := NewCachedProvider(provider, cache, 0); err == nil {
+		t.Error("Expected a zero ttl to be rejected")
+	}
+}
+
+func TestFileRateCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rates.json")
+	ctx := context.Background()
+	c, err := NewFileRateCache(path)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, found, err := c.Get(ctx, Pair{"EUR", "USD"}); found || err != nil {
+		t.Errorf("Expected an empty cache but got %v %v", found, err)
+	}
+
+	if err := c.Set(ctx, Pair{"EUR", "USD"}, 1.08, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+
+	c.Set(ctx, Pair{"USD", "JPY"}, 150, time.Hour)
+
+	// another replica, or the process after a restart
+	replica, err := NewFileRateCache(path)
+
+	if rate, found, _ := replica.Get(ctx, Pair{"EUR", "USD"}); err != nil || !found || rate != 1.08 {
+		t.Errorf("Expected the rate to be shared but got %v %v %v", rate, found, err)
+	}
+
+	replica.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+
+	if _, found, _ := replica.Get(ctx, Pair{"USD", "JPY"}); found {
+		t.Error("Expected the rate to expire")
+	}
+
+	if err := c.Set(ctx, Pair{"EUR", "USD"}, -1, time.Hour); err == nil {
+		t.Error("Expected an invalid rate to be rejected")
+	}
+
+	os.WriteFile(path, []byte("not json"), 0o644)
+	os.Chtimes(path, time.Now(), time.Now().Add(time.Hour))
+
+	if _, _, err := c.Get(ctx, Pair{"EUR", "USD"}); err == nil {
+		t.Error("Expected an invalid file to be rejected")
+	}
+
+	if _, err := NewFileRateCache(path); err == nil {
+		t.Error("Expected an invalid file to be rejected")
+	}
+}
+
+func TestRedisRateCache(t *testing.T) {
+	redis := fakeRedis{}
+	c := NewRedisRateCache(redis, "rates:")
+	ctx := context.Background()
+
+	if err := c.Set(ctx, Pair{"EUR", "USD"}, 1.0825, time.Hour); err != nil || redis["rates:EUR/USD"] != "1.0825" {
+		t.Errorf("Expected the rate under its key but got %v %v", redis, err)
+	}
+
+	if rate, found, err := c.Get(ctx, Pair{"EUR", "USD"}); err != nil || !found || rate != 1.0825 {
+		t.Errorf("Expected 1.0825 but got %v %v %v", rate, found, err)
+	}
+
+	if _, found, err := c.Get(ctx, Pair{"USD", "JPY"}); found || err != nil {
+		t.Errorf("Expected a miss but got %v %v", found, err)
+	}
+
+	redis["rates:USD/JPY"] = "NaN"
+
+	if _, _, err := c.Get(ctx, Pair{"USD", "JPY"}); err == nil {
+		t.Error("Expected an invalid value to be rejected")
+	}
+}
+
+// brokenCache fails every lookup
+type brokenCache struct{}
+
+func (brokenCache) Get(context.Context, Pair) (float64, bool, error) {
+	return 0, false, errors.New("connection refused")
+}
+
+func (brokenCache) Set(context.Context, Pair, float64, time.Duration) error {
+	return nil
+}
+
+func TestCachedProviderWhenCacheFails(t *testing.T) {
+	p, _ := NewCachedProvider(RateProviderFunc(func(from, to string) (float64, error) { return 1, nil }), brokenCache{}, time.Minute)
+
+	if _, err := p.Rate("EUR", "USD"); err == nil {
+		t.Error("Expected the cache failure to be reported")
+	}
+}
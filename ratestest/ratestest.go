@@ -0,0 +1,125 @@
+// Package ratestest provides a money.RateProvider for tests, whose rates,
+// failures and latencies follow a script over a virtual clock, so code depending
+// on exchange rates can be tested deterministically.
+//
+//	p := ratestest.New()
+//	p.Script("EUR", "USD",
+//		ratestest.Step{Rate: 1.08},
+//		ratestest.Step{At: time.Hour, Err: errors.New("feed down")},
+//		ratestest.Step{At: 2 * time.Hour, Rate: 1.10, Latency: time.Second})
+//
+//	exchange, _ := money.NewExchange("USD", p)
+//	p.Advance(2 * time.Hour)
+package ratestest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/joiggama/money"
+)
+
+// Step is the behavior of a pair from At, the time elapsed on the virtual clock
+// of the Provider, until the next step: calls take Latency and fail with Err,
+// or return Rate when Err is nil
+type Step struct {
+	At      time.Duration
+	Rate    float64
+	Err     error
+	Latency time.Duration
+}
+
+// Provider is a money.RateProvider following a script per pair. Pairs without
+// a script, or before their first step, fail with money.ErrNoRate. It is safe
+// for concurrent use.
+type Provider struct {
+	// Sleep, when set, is called with the latency of each call, e.g. time.Sleep
+	// to test timeouts; the latency always advances the virtual clock
+	Sleep func(time.Duration)
+
+	mu      sync.Mutex
+	elapsed time.Duration
+	scripts map[money.Pair][]Step
+	calls   map[money.Pair]int
+}
+
+var _ money.RateProvider = (*Provider)(nil)
+
+// New returns a Provider without scripts, its virtual clock at zero
+func New() *Provider {
+	return &Provider{scripts: map[money.Pair][]Step{}, calls: map[money.Pair]int{}}
+}
+
+// Script replaces the steps of the pair from/to, in any order
+func (p *Provider) Script(from, to string, steps ...Step) {
+	steps = append([]Step(nil), steps...)
+	sort.SliceStable(steps, func(i, j int) bool { return steps[i].At < steps[j].At })
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.scripts[pair(from, to)] = steps
+}
+
+// Advance moves the virtual clock forward by d
+func (p *Provider) Advance(d time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.elapsed += d
+}
+
+// Elapsed returns the time elapsed on the virtual clock, advanced by Advance
+// and by the latency of calls
+func (p *Provider) Elapsed() time.Duration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.elapsed
+}
+
+// Calls returns the number of calls for the pair from/to
+func (p *Provider) Calls(from, to string) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.calls[pair(from, to)]
+}
+
+// Rate follows the step of the pair current on the virtual clock
+func (p *Provider) Rate(from, to string) (float64, error) {
+	key := pair(from, to)
+
+	p.mu.Lock()
+	p.calls[key]++
+	steps := p.scripts[key]
+	i := sort.Search(len(steps), func(i int) bool { return steps[i].At > p.elapsed }) - 1
+
+	if i < 0 {
+		p.mu.Unlock()
+		return 0, fmt.Errorf("%w for %s", money.ErrNoRate, key)
+	}
+
+	step := steps[i]
+	p.elapsed += step.Latency
+	sleep := p.Sleep
+	p.mu.Unlock()
+
+	if sleep != nil && step.Latency > 0 {
+		sleep(step.Latency)
+	}
+
+	if step.Err != nil {
+		return 0, step.Err
+	}
+
+	return step.Rate, nil
+}
+
+// pair returns the pair from/to in upper case
+func pair(from, to string) money.Pair {
+	return money.Pair{Base: strings.ToUpper(from), Quote: strings.ToUpper(to)}
+}
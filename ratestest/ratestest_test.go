@@ -0,0 +1,66 @@
+package ratestest
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/joiggama/money"
+)
+
+func TestProvider(t *testing.T) {
+	outage := errors.New("feed down")
+	p := New()
+	p.Script("EUR", "USD",
+		Step{At: 2 * time.Hour, Rate: 1.10, Latency: time.Second},
+		Step{Rate: 1.08},
+		Step{At: time.Hour, Err: outage})
+
+	if rate, err := p.Rate("eur", "usd"); err != nil || rate != 1.08 {
+		t.Errorf("Expected 1.08 but got %v %v", rate, err)
+	}
+
+	p.Advance(time.Hour)
+
+	if _, err := p.Rate("EUR", "USD"); !errors.Is(err, outage) {
+		t.Errorf("Expected the scripted failure but got %v", err)
+	}
+
+	p.Advance(time.Hour)
+	var slept time.Duration
+	p.Sleep = func(d time.Duration) { slept += d }
+
+	if rate, err := p.Rate("EUR", "USD"); err != nil || rate != 1.10 {
+		t.Errorf("Expected 1.10 but got %v %v", rate, err)
+	}
+
+	if elapsed := p.Elapsed(); elapsed != 2*time.Hour+time.Second || slept != time.Second {
+		t.Errorf("Expected the latency to advance the clock but got %s and slept %s", elapsed, slept)
+	}
+
+	if n := p.Calls("EUR", "USD"); n != 3 {
+		t.Errorf("Expected 3 calls but got %d", n)
+	}
+
+	if _, err := p.Rate("EUR", "GBP"); !errors.Is(err, money.ErrNoRate) {
+		t.Errorf("Expected ErrNoRate but got %v", err)
+	}
+}
+
+func TestProviderWithExchange(t *testing.T) {
+	p := New()
+	p.Script("USD", "JPY", Step{Rate: 150}, Step{At: time.Minute, Rate: 160})
+
+	e, _ := money.NewExchange("USD", p)
+	m, _ := money.FromMinorUnits(100, "USD")
+
+	if converted, err := e.ConvertMoney(m, "JPY"); err != nil || converted.MinorUnits() != 150 {
+		t.Errorf("Expected ¥150 but got %s %v", converted, err)
+	}
+
+	p.Advance(time.Minute)
+
+	if converted, err := e.ConvertMoney(m, "JPY"); err != nil || converted.MinorUnits() != 160 {
+		t.Errorf("Expected ¥160 but got %s %v", converted, err)
+	}
+}
@@ -0,0 +1,153 @@
+package money
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Matcher pairs the flows of two transaction sets, such as a ledger and a bank
+// statement, by currency, amount and date
+type Matcher struct {
+	// Tolerances are the largest differences of amounts matched, one per
+	// currency; flows in other currencies only match equal amounts
+	Tolerances []Money
+
+	// Window is the largest difference of dates matched
+	Window time.Duration
+}
+
+// Match is a pair of flows matched by Matcher.Match, with the indexes of the
+// flows and B's amount less A's
+type Match struct {
+	A, B       int
+	Difference Money
+}
+
+// Reconciliation is the result of Matcher.Match: the matched pairs in the order
+// of a, and the indexes of the flows left unmatched in each set
+type Reconciliation struct {
+	Matches    []Match
+	UnmatchedA []int
+	UnmatchedB []int
+}
+
+// Match pairs the flows of a with those of b in the same currency whose amounts
+// differ by at most the tolerance of the currency and dates by at most Window.
+// Each flow is matched once: the closest amounts are paired first, then the
+// closest dates, then the earliest flows of a and b. It fails for negative or
+// repeated tolerances and a negative window.
+func (mt Matcher) Match(a, b []CashFlow) (Reconciliation, error) {
+	tolerances := map[string]int64{}
+
+	for _, t := range mt.Tolerances {
+		if _, ok := tolerances[t.currency]; ok || t.IsNegative() {
+			return Reconciliation{}, fmt.Errorf("money: invalid tolerance %s", t)
+		}
+
+		tolerances[t.currency] = t.amount
+	}
+
+	if mt.Window < 0 {
+		return Reconciliation{}, fmt.Errorf("money: invalid window %s", mt.Window)
+	}
+
+	// the flows of b per currency, by date
+	byCurrency := map[string][]int{}
+
+	for j, flow := range b {
+		byCurrency[flow.Amount.currency] = append(byCurrency[flow.Amount.currency], j)
+	}
+
+	for _, indexes := range byCurrency {
+		sort.SliceStable(indexes, func(x, y int) bool { return b[indexes[x]].Date.Before(b[indexes[y]].Date) })
+	}
+
+	type candidate struct {
+		a, b     int
+		amount   uint64
+		duration time.Duration
+	}
+
+	var candidates []candidate
+
+	for i, flow := range a {
+		indexes := byCurrency[flow.Amount.currency]
+		from := flow.Date.Add(-mt.Window)
+		k := sort.Search(len(indexes), func(k int) bool { return !b[indexes[k]].Date.Before(from) })
+
+		for ; k < len(indexes) && !b[indexes[k]].Date.After(flow.Date.Add(mt.Window)); k++ {
+			other := b[indexes[k]]
+			amount := amountDistance(flow.Amount.amount, other.Amount.amount)
+
+			if amount > uint64(tolerances[flow.Amount.currency]) {
+				continue
+			}
+
+			duration := other.Date.Sub(flow.Date)
+
+			if duration < 0 {
+				duration = -duration
+			}
+
+			candidates = append(candidates, candidate{i, indexes[k], amount, duration})
+		}
+	}
+
+	sort.Slice(candidates, func(x, y int) bool {
+		cx, cy := candidates[x], candidates[y]
+
+		switch {
+		case cx.amount != cy.amount:
+			return cx.amount < cy.amount
+		case cx.duration != cy.duration:
+			return cx.duration < cy.duration
+		case cx.a != cy.a:
+			return cx.a < cy.a
+		}
+
+		return cx.b < cy.b
+	})
+
+	pairedA, pairedB := make([]int, len(a)), make([]bool, len(b))
+
+	for i := range pairedA {
+		pairedA[i] = -1
+	}
+
+	for _, c := range candidates {
+		if pairedA[c.a] < 0 && !pairedB[c.b] {
+			pairedA[c.a], pairedB[c.b] = c.b, true
+		}
+	}
+
+	var r Reconciliation
+
+	for i, j := range pairedA {
+		if j < 0 {
+			r.UnmatchedA = append(r.UnmatchedA, i)
+			continue
+		}
+
+		// within an int64 tolerance, so the difference cannot overflow
+		difference, _ := b[j].Amount.Subtract(a[i].Amount)
+		r.Matches = append(r.Matches, Match{i, j, difference})
+	}
+
+	for j, paired := range pairedB {
+		if !paired {
+			r.UnmatchedB = append(r.UnmatchedB, j)
+		}
+	}
+
+	return r, nil
+}
+
+// amountDistance returns |x - y| without overflowing
+func amountDistance(x, y int64) uint64 {
+	if x > y {
+		return uint64(x) - uint64(y)
+	}
+
+	return uint64(y) - uint64(x)
+}
@@ -0,0 +1,69 @@
+package money
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMatcherMatch(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+
+	ledger := []CashFlow{
+		{day(1), Money{10000, "USD"}},
+		{day(2), Money{-2500, "USD"}},
+		{day(3), Money{10000, "USD"}},
+		{day(4), Money{900, "EUR"}},
+		{day(20), Money{700, "USD"}},
+	}
+
+	statement := []CashFlow{
+		{day(4), Money{10000, "USD"}},
+		{day(2), Money{9998, "USD"}},
+		{day(3), Money{-2500, "USD"}},
+		{day(4), Money{901, "EUR"}},
+		{day(5), Money{700, "USD"}},
+	}
+
+	mt := Matcher{Tolerances: []Money{{5, "USD"}}, Window: 3 * 24 * time.Hour}
+	r, err := mt.Match(ledger, statement)
+
+	expected := Reconciliation{
+		Matches: []Match{
+			{0, 1, Money{-2, "USD"}},
+			{1, 2, Money{0, "USD"}},
+			{2, 0, Money{0, "USD"}},
+		},
+		UnmatchedA: []int{3, 4},
+		UnmatchedB: []int{3, 4},
+	}
+
+	if err != nil || !reflect.DeepEqual(r, expected) {
+		t.Errorf("Expected %v but got %v %v", expected, r, err)
+	}
+}
+
+func TestMatcherMatchPrefersClosestAmounts(t *testing.T) {
+	day := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	a := []CashFlow{{day, Money{math.MinInt64, "USD"}}, {day, Money{1000, "USD"}}}
+	b := []CashFlow{{day, Money{998, "USD"}}, {day, Money{1000, "USD"}}, {day, Money{math.MaxInt64, "USD"}}}
+
+	r, err := Matcher{Tolerances: []Money{{5, "USD"}}}.Match(a, b)
+
+	if err != nil || !reflect.DeepEqual(r.Matches, []Match{{1, 1, Money{0, "USD"}}}) {
+		t.Errorf("Expected the exact amount to be matched but got %v %v", r, err)
+	}
+}
+
+func TestMatcherMatchWhenInvalid(t *testing.T) {
+	for _, mt := range []Matcher{
+		{Tolerances: []Money{{-1, "USD"}}},
+		{Tolerances: []Money{{1, "USD"}, {2, "USD"}}},
+		{Window: -time.Hour},
+	} {
+		if _, err := mt.Match(nil, nil); err == nil {
+			t.Errorf("Expected %v to be rejected", mt)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// RoundingMode selects how an amount between two minor units is rounded
+type RoundingMode int
+
+const (
+	// HalfUp rounds to the nearest minor unit, ties away from zero, as the
+	// package does unless told otherwise
+	HalfUp RoundingMode = iota
+
+	// HalfEven rounds to the nearest minor unit, ties to the even one, the
+	// banker's rounding
+	HalfEven
+
+	// HalfDown rounds to the nearest minor unit, ties toward zero
+	HalfDown
+
+	// TowardZero truncates
+	TowardZero
+
+	// AwayFromZero rounds any fraction up in magnitude
+	AwayFromZero
+
+	// Floor rounds toward negative infinity
+	Floor
+
+	// Ceiling rounds toward positive infinity
+	Ceiling
+)
+
+var roundingModeNames = []string{"HalfUp", "HalfEven", "HalfDown", "TowardZero", "AwayFromZero", "Floor", "Ceiling"}
+
+// String returns the name of the mode, e.g. "HalfEven"
+func (mode RoundingMode) String() string {
+	if mode < 0 || int(mode) >= len(roundingModeNames) {
+		return fmt.Sprintf("RoundingMode(%d)", int(mode))
+	}
+
+	return roundingModeNames[mode]
+}
+
+// valid reports whether mode is one of the declared modes
+func (mode RoundingMode) valid() bool {
+	return mode >= 0 && int(mode) < len(roundingModeNames)
+}
+
+// roundRatMode rounds r to an integer by mode, reporting whether it fits in an int64
+func roundRatMode(r *big.Rat, mode RoundingMode) (int64, bool) {
+	num, den := new(big.Int).Abs(r.Num()), r.Denom()
+	q, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	negative := r.Sign() < 0
+
+	if rem.Sign() != 0 {
+		half := rem.Lsh(rem, 1).Cmp(den)
+		up := false
+
+		switch mode {
+		case HalfUp:
+			up = half >= 0
+		case HalfEven:
+			up = half > 0 || half == 0 && q.Bit(0) == 1
+		case HalfDown:
+			up = half > 0
+		case AwayFromZero:
+			up = true
+		case Floor:
+			up = negative
+		case Ceiling:
+			up = !negative
+		}
+
+		if up {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+
+	if negative {
+		q.Neg(q)
+	}
+
+	return q.Int64(), q.IsInt64()
+}
@@ -0,0 +1,47 @@
+package money
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestRoundRatMode(t *testing.T) {
+	values := []struct {
+		r    *big.Rat
+		mode RoundingMode
+		want int64
+	}{
+		{big.NewRat(5, 2), HalfUp, 3},
+		{big.NewRat(-5, 2), HalfUp, -3},
+		{big.NewRat(5, 2), HalfEven, 2},
+		{big.NewRat(7, 2), HalfEven, 4},
+		{big.NewRat(-5, 2), HalfEven, -2},
+		{big.NewRat(5, 2), HalfDown, 2},
+		{big.NewRat(27, 10), HalfDown, 3},
+		{big.NewRat(27, 10), TowardZero, 2},
+		{big.NewRat(-27, 10), TowardZero, -2},
+		{big.NewRat(21, 10), AwayFromZero, 3},
+		{big.NewRat(-21, 10), AwayFromZero, -3},
+		{big.NewRat(-21, 10), Floor, -3},
+		{big.NewRat(21, 10), Floor, 2},
+		{big.NewRat(-29, 10), Ceiling, -2},
+		{big.NewRat(21, 10), Ceiling, 3},
+		{big.NewRat(4, 1), Ceiling, 4},
+	}
+
+	for _, v := range values {
+		if got, ok := roundRatMode(v.r, v.mode); !ok || got != v.want {
+			t.Errorf("Expected %s rounded %s to be %d but got %d", v.r, v.mode, v.want, got)
+		}
+	}
+}
+
+func TestRoundingModeString(t *testing.T) {
+	if s := HalfEven.String(); s != "HalfEven" {
+		t.Errorf("Expected HalfEven but got %s", s)
+	}
+
+	if s := RoundingMode(9).String(); s != "RoundingMode(9)" {
+		t.Errorf("Expected RoundingMode(9) but got %s", s)
+	}
+}
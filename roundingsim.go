@@ -0,0 +1,157 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+)
+
+// RoundingOutcome compares, for a rounding mode, the ways of rounding a price
+// list multiplied by a factor, in minor units of its currency
+type RoundingOutcome struct {
+	Mode RoundingMode
+
+	// Exact is the unrounded total
+	Exact *big.Rat
+
+	// LineRounded is the sum of the lines rounded one by one
+	LineRounded Money
+
+	// TotalRounded is the exact total rounded once
+	TotalRounded Money
+
+	// Drift is LineRounded less TotalRounded, the cents gained or lost by
+	// rounding each line
+	Drift Money
+
+	// LineError is the sum of the absolute differences of the rounded lines
+	// to the exact ones
+	LineError *big.Rat
+
+	// Reallocated counts the lines which would change if TotalRounded were
+	// allocated back to the lines by largest remainder, so they add up to it
+	Reallocated int
+}
+
+// SimulateRounding multiplies each price by the plain decimal factor, e.g.
+// "1.19" to add VAT or "0.85" for a discount, and compares the totals of the
+// rounding modes, all of them when none are given, to see the effect of a
+// change of rounding policy before making it. It fails for an empty list,
+// prices in different currencies, invalid factors or modes and totals
+// overflowing.
+func SimulateRounding(prices []Money, factor string, modes ...RoundingMode) ([]RoundingOutcome, error) {
+	if len(prices) == 0 {
+		return nil, fmt.Errorf("money: no prices to simulate")
+	}
+
+	f, err := parseFactor(factor)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(modes) == 0 {
+		for mode := range roundingModeNames {
+			modes = append(modes, RoundingMode(mode))
+		}
+	}
+
+	exact := make([]*big.Rat, len(prices))
+	total := new(big.Rat)
+
+	for i, price := range prices {
+		if err := prices[0].sameCurrency(price); err != nil {
+			return nil, err
+		}
+
+		exact[i] = new(big.Rat).Mul(new(big.Rat).SetInt64(price.amount), f)
+		total.Add(total, exact[i])
+	}
+
+	code := prices[0].currency
+	outcomes := make([]RoundingOutcome, 0, len(modes))
+
+	for _, mode := range modes {
+		if !mode.valid() {
+			return nil, fmt.Errorf("money: invalid rounding mode %s", mode)
+		}
+
+		lines := make([]int64, len(exact))
+		lineSum, lineError := new(big.Int), new(big.Rat)
+
+		for i, e := range exact {
+			units, ok := roundRatMode(e, mode)
+
+			if !ok {
+				return nil, fmt.Errorf("%w: line %d of the simulation", ErrOverflow, i)
+			}
+
+			lines[i] = units
+			lineSum.Add(lineSum, big.NewInt(units))
+			diff := new(big.Rat).Sub(new(big.Rat).SetInt64(units), e)
+			lineError.Add(lineError, diff.Abs(diff))
+		}
+
+		rounded, ok := roundRatMode(total, mode)
+
+		if !ok || !lineSum.IsInt64() {
+			return nil, fmt.Errorf("%w: total of the simulation", ErrOverflow)
+		}
+
+		drift := new(big.Int).Sub(lineSum, big.NewInt(rounded))
+
+		if !drift.IsInt64() {
+			return nil, fmt.Errorf("%w: drift of the simulation", ErrOverflow)
+		}
+
+		reallocated := 0
+
+		for i, units := range largestRemainder(exact, rounded) {
+			if units != lines[i] {
+				reallocated++
+			}
+		}
+
+		outcomes = append(outcomes, RoundingOutcome{
+			Mode:         mode,
+			Exact:        new(big.Rat).Set(total),
+			LineRounded:  Money{lineSum.Int64(), code},
+			TotalRounded: Money{rounded, code},
+			Drift:        Money{drift.Int64(), code},
+			LineError:    lineError,
+			Reallocated:  reallocated,
+		})
+	}
+
+	return outcomes, nil
+}
+
+// largestRemainder rounds the exact lines down and hands the units left to
+// reach total to the lines with the largest fractions, the first ones on ties
+func largestRemainder(exact []*big.Rat, total int64) []int64 {
+	lines := make([]int64, len(exact))
+	fractions := make([]*big.Rat, len(exact))
+	left := total
+
+	for i, e := range exact {
+		lines[i], _ = roundRatMode(e, Floor)
+		fractions[i] = new(big.Rat).Sub(e, new(big.Rat).SetInt64(lines[i]))
+		left -= lines[i]
+	}
+
+	order := make([]int, len(exact))
+
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool { return fractions[order[i]].Cmp(fractions[order[j]]) > 0 })
+
+	// total lies between the sums of the lines rounded down and up, so at most
+	// one unit goes to each line
+	for _, i := range order[:left] {
+		lines[i]++
+	}
+
+	return lines
+}
@@ -0,0 +1,78 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestSimulateRounding(t *testing.T) {
+	// three lines of $0.05 with 10% off are $0.045 each, $0.135 in all
+	prices := []Money{{5, "USD"}, {5, "USD"}, {5, "USD"}}
+	outcomes, err := SimulateRounding(prices, "0.9", HalfUp, HalfEven, TowardZero)
+
+	if err != nil || len(outcomes) != 3 {
+		t.Fatalf("Expected 3 outcomes but got %v %v", outcomes, err)
+	}
+
+	values := []struct {
+		line, total, drift int64
+		reallocated        int
+	}{
+		{15, 14, 1, 1},
+		{12, 14, -2, 2},
+		{12, 13, -1, 1},
+	}
+
+	for i, v := range values {
+		o := outcomes[i]
+
+		if o.LineRounded.amount != v.line || o.TotalRounded.amount != v.total || o.Drift.amount != v.drift || o.Reallocated != v.reallocated {
+			t.Errorf("Expected %s to give %d, %d, %d and %d reallocated but got %+v", o.Mode, v.line, v.total, v.drift, v.reallocated, o)
+		}
+
+		if o.Exact.Cmp(big.NewRat(27, 2)) != 0 {
+			t.Errorf("Expected an exact total of 13.5 but got %s", o.Exact)
+		}
+	}
+
+	if outcomes[0].LineError.Cmp(big.NewRat(3, 2)) != 0 {
+		t.Errorf("Expected a line error of 1.5 but got %s", outcomes[0].LineError)
+	}
+
+	if all, err := SimulateRounding(prices, "1"); err != nil || len(all) != len(roundingModeNames) || all[3].Drift.amount != 0 {
+		t.Errorf("Expected every mode without drift but got %v %v", all, err)
+	}
+}
+
+func TestLargestRemainder(t *testing.T) {
+	exact := []*big.Rat{big.NewRat(45, 10), big.NewRat(46, 10), big.NewRat(-19, 10)}
+	lines := largestRemainder(exact, 7)
+
+	if lines[0] != 4 || lines[1] != 5 || lines[2] != -2 {
+		t.Errorf("Expected [4 5 -2] but got %v", lines)
+	}
+}
+
+func TestSimulateRoundingWhenInvalid(t *testing.T) {
+	if _, err := SimulateRounding(nil, "1"); err == nil {
+		t.Error("Expected an empty list to be rejected")
+	}
+
+	if _, err := SimulateRounding([]Money{{1, "USD"}, {1, "EUR"}}, "1"); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+
+	if _, err := SimulateRounding([]Money{{1, "USD"}}, "x"); err == nil {
+		t.Error("Expected an invalid factor to be rejected")
+	}
+
+	if _, err := SimulateRounding([]Money{{1, "USD"}}, "1", RoundingMode(9)); err == nil {
+		t.Error("Expected an invalid mode to be rejected")
+	}
+
+	if _, err := SimulateRounding([]Money{{math.MaxInt64, "USD"}, {math.MaxInt64, "USD"}}, "1"); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow but got %v", err)
+	}
+}
@@ -0,0 +1,52 @@
+package money
+
+import (
+	"fmt"
+	"math"
+)
+
+// RoundUpTo rounds m up to the next multiple of step, returning the rounded
+// amount and the difference to donate, e.g. $9.30 to $10.00 donating $0.70.
+// An amount already a multiple of step is kept, donating zero.
+func RoundUpTo(m, step Money) (rounded, donation Money, err error) {
+	if err := m.sameCurrency(step); err != nil {
+		return Money{}, Money{}, err
+	}
+
+	if !step.IsPositive() || m.IsNegative() {
+		return Money{}, Money{}, fmt.Errorf("money: cannot round %s up to a step of %s", m, step)
+	}
+
+	gap := (step.amount - m.amount%step.amount) % step.amount
+
+	if m.amount > math.MaxInt64-gap {
+		return Money{}, Money{}, fmt.Errorf("%w: %s rounded up to %s", ErrOverflow, m, step)
+	}
+
+	return Money{m.amount + gap, m.currency}, Money{gap, m.currency}, nil
+}
+
+// TotalByCurrency sums amounts per currency, e.g. to aggregate the donations
+// collected at checkout, failing when a total overflows
+func TotalByCurrency(amounts ...Money) (map[string]Money, error) {
+	totals := map[string]Money{}
+
+	for _, m := range amounts {
+		total, ok := totals[m.currency]
+
+		if !ok {
+			totals[m.currency] = m
+			continue
+		}
+
+		total, err := total.Add(m)
+
+		if err != nil {
+			return nil, err
+		}
+
+		totals[m.currency] = total
+	}
+
+	return totals, nil
+}
@@ -0,0 +1,60 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestRoundUpTo(t *testing.T) {
+	values := []struct {
+		m, step           Money
+		rounded, donation int64
+	}{
+		{Money{930, "USD"}, Money{100, "USD"}, 1000, 70},
+		{Money{1000, "USD"}, Money{100, "USD"}, 1000, 0},
+		{Money{0, "USD"}, Money{100, "USD"}, 0, 0},
+		{Money{1234, "JPY"}, Money{100, "JPY"}, 1300, 66},
+		{Money{1001, "USD"}, Money{500, "USD"}, 1500, 499},
+	}
+
+	for _, v := range values {
+		rounded, donation, err := RoundUpTo(v.m, v.step)
+
+		if err != nil || rounded.MinorUnits() != v.rounded || donation.MinorUnits() != v.donation {
+			t.Errorf("Expected %s to round up to %d donating %d but got %s %s %v", v.m, v.rounded, v.donation, rounded, donation, err)
+		}
+	}
+}
+
+func TestRoundUpToWhenInvalid(t *testing.T) {
+	if _, _, err := RoundUpTo(Money{930, "USD"}, Money{100, "EUR"}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+
+	for _, v := range [][2]Money{{{930, "USD"}, {0, "USD"}}, {{-930, "USD"}, {100, "USD"}}} {
+		if _, _, err := RoundUpTo(v[0], v[1]); err == nil {
+			t.Errorf("Expected %s rounded up to %s to fail", v[0], v[1])
+		}
+	}
+
+	if _, _, err := RoundUpTo(Money{math.MaxInt64, "USD"}, Money{100, "USD"}); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow but got %v", err)
+	}
+}
+
+func TestTotalByCurrency(t *testing.T) {
+	totals, err := TotalByCurrency(Money{70, "USD"}, Money{45, "EUR"}, Money{5, "USD"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(totals) != 2 || totals["USD"] != (Money{75, "USD"}) || totals["EUR"] != (Money{45, "EUR"}) {
+		t.Errorf("Expected 75 USD and 45 EUR but got %v", totals)
+	}
+
+	if _, err := TotalByCurrency(Money{math.MaxInt64, "USD"}, Money{1, "USD"}); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow but got %v", err)
+	}
+}
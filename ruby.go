@@ -0,0 +1,144 @@
+package money
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// FormatRuby returns a formatted price string replicating the ruby money gem
+// Money#format output, for applications that must keep byte identical results.
+//
+// Supported options use the gem rule names:
+//
+//	Options{
+//	  "currency":                    "USD",
+//	  "decimal_mark":                ",",
+//	  "display_free":                true,    // "free", or the given string, for zero amounts
+//	  "no_cents":                    true,    // truncates the sub unit
+//	  "no_cents_if_whole":           true,
+//	  "sign_before_symbol":          true,    // "-$1.00" instead of "$-1.00"
+//	  "sign_positive":               true,
+//	  "symbol":                      "US$",   // or false to omit it
+//	  "symbol_after_without_space":  true,
+//	  "symbol_before_without_space": false,
+//	  "symbol_position":             "after", // "before" or "after"
+//	  "thousands_separator":         ".",     // or false to omit it
+//	  "with_currency":               true,
+//	}
+//
+// Amounts are rounded half to even, the gem's default rounding mode.
+func FormatRuby(val float64, opts ...Options) (result string) {
+	defer guard(nil)
+
+	options := Options{"currency": "USD"}
+
+	if len(opts) > 0 {
+		options = override(options, opts[0])
+	}
+
+	code := options["currency"].(string)
+	c := currencies[code]
+	warnInexactFloat(val, code)
+
+	integer, fractional := splitDecimal(roundHalfEven(strconv.FormatFloat(math.Abs(val), 'f', -1, 64), c.exponent()))
+	zero := strings.Trim(integer+fractional, "0") == ""
+
+	if zero {
+		switch free := options["display_free"].(type) {
+		case string:
+			return free
+		case bool:
+			if free {
+				return "free"
+			}
+		}
+	}
+
+	if rubyRule(options, "no_cents") || rubyRule(options, "no_cents_if_whole") && strings.Trim(fractional, "0") == "" {
+		fractional = ""
+	}
+
+	separator := c.ThousandsSeparator
+
+	if s, ok := options["thousands_separator"]; ok {
+		separator, _ = s.(string)
+	}
+
+	mark := c.DecimalMark
+
+	if m, ok := options["decimal_mark"].(string); ok {
+		mark = m
+	}
+
+	result = separateThousands(integer, separator)
+
+	if fractional != "" {
+		result = result + mark + fractional
+	}
+
+	var sign, signBefore string
+
+	if val < 0 && !zero {
+		sign = "-"
+	} else if rubyRule(options, "sign_positive") && !zero {
+		sign = "+"
+	}
+
+	if rubyRule(options, "sign_before_symbol") {
+		signBefore, sign = sign, ""
+	}
+
+	symbol := resolveSymbol(code, c)
+
+	switch s := options["symbol"].(type) {
+	case string:
+		symbol = s
+	case bool:
+		if !s {
+			symbol = ""
+		}
+	}
+
+	before := c.SymbolFirst
+
+	switch options["symbol_position"] {
+	case "before":
+		before = true
+	case "after":
+		before = false
+	}
+
+	switch {
+	case symbol == "":
+		result = signBefore + sign + result
+	case before:
+		var space string
+
+		if v, ok := options["symbol_before_without_space"].(bool); ok && !v {
+			space = " "
+		}
+
+		result = signBefore + symbol + space + sign + result
+	default:
+		space := " "
+
+		if rubyRule(options, "symbol_after_without_space") {
+			space = ""
+		}
+
+		result = signBefore + sign + result + space + symbol
+	}
+
+	if rubyRule(options, "with_currency") {
+		result = result + " " + code
+	}
+
+	return result
+}
+
+// rubyRule reports whether a boolean gem rule is enabled
+func rubyRule(options Options, rule string) bool {
+	v, _ := options[rule].(bool)
+	return v
+}
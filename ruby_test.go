@@ -0,0 +1,50 @@
+package money
+
+import (
+	"testing"
+)
+
+// Expected values follow the ruby money gem Money#format documentation
+func TestFormatRuby(t *testing.T) {
+	values := []struct {
+		val      float64
+		options  Options
+		expected string
+	}{
+		{1, Options{}, "$1.00"},
+		{1000, Options{}, "$1,000.00"},
+		{-1000, Options{}, "$-1,000.00"},
+		{-1000, Options{"sign_before_symbol": true}, "-$1,000.00"},
+		{1000, Options{"sign_positive": true}, "$+1,000.00"},
+		{1, Options{"with_currency": true}, "$1.00 USD"},
+		{100.5, Options{"no_cents": true}, "$100"},
+		{100.99, Options{"no_cents": true}, "$100"},
+		{100, Options{"no_cents_if_whole": true}, "$100"},
+		{100.34, Options{"no_cents_if_whole": true}, "$100.34"},
+		{1, Options{"symbol": false}, "1.00"},
+		{1, Options{"symbol": "£"}, "£1.00"},
+		{0, Options{"display_free": true}, "free"},
+		{0, Options{"display_free": "gratis"}, "gratis"},
+		{1, Options{"display_free": true}, "$1.00"},
+		{100, Options{"symbol_position": "after"}, "100.00 $"},
+		{1, Options{"symbol_before_without_space": false}, "$ 1.00"},
+		{1000, Options{"thousands_separator": false}, "$1000.00"},
+		{1000, Options{"thousands_separator": "."}, "$1.000.00"},
+		{1, Options{"decimal_mark": ","}, "$1,00"},
+		{1000.5, Options{"thousands_separator": ".", "decimal_mark": ","}, "$1.000,50"},
+		{1000, Options{"thousands_separator": ".", "decimal_mark": ",", "no_cents": true}, "$1.000"},
+		{1000, Options{"currency": "BOB", "thousands_separator": ".", "decimal_mark": ",", "no_cents": true}, "Bs.1.000"},
+		{1000, Options{"currency": "EUR"}, "€1.000,00"},
+		{100, Options{"currency": "JPY"}, "¥100"},
+		{10, Options{"currency": "AFN"}, "10.00 ؋"},
+		{10, Options{"currency": "AFN", "symbol_after_without_space": true}, "10.00؋"},
+		{1.005, Options{}, "$1.00"},
+		{1.015, Options{}, "$1.02"},
+	}
+
+	for _, v := range values {
+		if result := FormatRuby(v.val, v.options); result != v.expected {
+			t.Errorf("Expected %s but got %s", v.expected, result)
+		}
+	}
+}
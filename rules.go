@@ -0,0 +1,36 @@
+package money
+
+import (
+	"sort"
+)
+
+// DisplayRule describes how amounts in a currency are displayed, for review by QA teams
+type DisplayRule struct {
+	Currency           string
+	Symbol             string
+	SymbolFirst        bool
+	ThousandsSeparator string
+	DecimalMark        string
+	Example            string
+}
+
+// DisplayRules returns the display rules of every known currency, ordered by ISO code.
+// Example holds 1234567.89 formatted with default options in that currency.
+func DisplayRules() []DisplayRule {
+	rules := make([]DisplayRule, 0, len(currencies))
+
+	for code, c := range currencies {
+		rules = append(rules, DisplayRule{
+			Currency:           code,
+			Symbol:             resolveSymbol(code, c),
+			SymbolFirst:        c.SymbolFirst,
+			ThousandsSeparator: c.ThousandsSeparator,
+			DecimalMark:        c.DecimalMark,
+			Example:            Format(1234567.89, Options{"currency": code}),
+		})
+	}
+
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Currency < rules[j].Currency })
+
+	return rules
+}
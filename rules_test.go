@@ -0,0 +1,37 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestDisplayRules(t *testing.T) {
+	rules := DisplayRules()
+
+	if len(rules) != len(currencies) {
+		t.Errorf("Expected %d rules but got %d", len(currencies), len(rules))
+	}
+
+	for i := 1; i < len(rules); i++ {
+		if rules[i-1].Currency >= rules[i].Currency {
+			t.Errorf("Expected rules to be ordered by currency, but %s precedes %s", rules[i-1].Currency, rules[i].Currency)
+		}
+	}
+}
+
+func TestDisplayRulesWhenEuro(t *testing.T) {
+	for _, rule := range DisplayRules() {
+		if rule.Currency != "EUR" {
+			continue
+		}
+
+		expected := DisplayRule{"EUR", "€", true, ".", ",", "€1.234.567,89"}
+
+		if rule != expected {
+			t.Errorf("Expected %v but got %v", expected, rule)
+		}
+
+		return
+	}
+
+	t.Error("Expected EUR to have a display rule")
+}
@@ -0,0 +1,119 @@
+package money
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+)
+
+// Scanner reads Money from a stream of delimited records, one amount per record,
+// such as a settlement file, without loading the whole stream. Records are parsed
+// as Parse does; empty records are skipped.
+type Scanner struct {
+	s        *bufio.Scanner
+	hint     string
+	m        Money
+	err      error
+	records  int
+	consumed int64
+	every    int
+	progress func(bytes int64, records int)
+}
+
+// NewScanner returns a Scanner reading records separated by delim from r, parsed
+// with opts
+func NewScanner(r io.Reader, delim byte, opts ...Options) *Scanner {
+	options := defaults()
+
+	if len(opts) > 0 {
+		noteDeprecated(opts[0])
+		options = override(options, opts[0])
+	}
+
+	s := &Scanner{s: bufio.NewScanner(r), hint: options["currency"].(string)}
+
+	s.s.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+		advance, token, err := splitRecord(data, atEOF, delim)
+		s.consumed += int64(advance)
+
+		return advance, token, err
+	})
+
+	return s
+}
+
+// SetProgress calls fn with the bytes consumed and the records read so far after
+// every given number of records, and once more at the end of the stream
+func (s *Scanner) SetProgress(every int, fn func(bytes int64, records int)) {
+	s.every, s.progress = every, fn
+}
+
+// Scan advances to the next amount, returning false at the end of the stream or
+// on the first error, reported by Err
+func (s *Scanner) Scan() bool {
+	defer guard(&s.err)
+
+	for s.s.Scan() {
+		token := s.s.Bytes()
+
+		if len(bytes.TrimSpace(token)) == 0 {
+			continue
+		}
+
+		s.records++
+		s.m, s.err = parseObserved(string(token), s.hint, false)
+
+		if s.progress != nil && s.every > 0 && s.records%s.every == 0 {
+			s.progress(s.consumed, s.records)
+		}
+
+		return s.err == nil
+	}
+
+	s.err = s.s.Err()
+
+	if s.progress != nil {
+		s.progress(s.consumed, s.records)
+	}
+
+	return false
+}
+
+// Money returns the amount read by the last call to Scan
+func (s *Scanner) Money() Money {
+	return s.m
+}
+
+// Record returns the number of the last record read, counting from 1
+func (s *Scanner) Record() int {
+	return s.records
+}
+
+// Err returns the first error met, a *ParseError for an invalid record
+func (s *Scanner) Err() error {
+	return s.err
+}
+
+// splitRecord is a bufio.SplitFunc for records ending in delim, trimming a
+// carriage return before a newline delimiter
+func splitRecord(data []byte, atEOF bool, delim byte) (int, []byte, error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+
+	if i := bytes.IndexByte(data, delim); i >= 0 {
+		token := data[:i]
+
+		if delim == '\n' {
+			token = bytes.TrimSuffix(token, []byte{'\r'})
+		}
+
+		return i + 1, token, nil
+	}
+
+	if atEOF {
+		return len(data), data, nil
+	}
+
+	return 0, nil, nil
+}
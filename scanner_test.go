@@ -0,0 +1,70 @@
+package money
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestScanner(t *testing.T) {
+	input := "$1,234.56\r\n\n€10,00\n-$0.05"
+	s := NewScanner(strings.NewReader(input), '\n')
+
+	var progress [][2]int64
+
+	s.SetProgress(2, func(bytes int64, records int) {
+		progress = append(progress, [2]int64{bytes, int64(records)})
+	})
+
+	expected := []Money{{123456, "USD"}, {1000, "EUR"}, {-5, "USD"}}
+	var got []Money
+
+	for s.Scan() {
+		got = append(got, s.Money())
+	}
+
+	if s.Err() != nil {
+		t.Fatal(s.Err())
+	}
+
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d amounts but got %v", len(expected), got)
+	}
+
+	for i := range got {
+		if got[i] != expected[i] {
+			t.Errorf("Expected %s but got %s", expected[i], got[i])
+		}
+	}
+
+	if len(progress) != 2 || progress[0] != [2]int64{21, 2} || progress[1] != [2]int64{int64(len(input)), 3} {
+		t.Errorf("Expected progress after 2 records and at the end but got %v", progress)
+	}
+}
+
+func TestScannerWithDelimiter(t *testing.T) {
+	s := NewScanner(strings.NewReader("1,00;2,50;"), ';', Options{"currency": "EUR"})
+
+	var total int64
+
+	for s.Scan() {
+		total += s.Money().MinorUnits()
+	}
+
+	if s.Err() != nil || total != 350 || s.Record() != 2 {
+		t.Errorf("Expected 2 records adding up to 350 but got %d %d %v", s.Record(), total, s.Err())
+	}
+}
+
+func TestScannerWhenInvalid(t *testing.T) {
+	s := NewScanner(strings.NewReader("$1.00\n$1.234\n$3.00\n"), '\n')
+
+	for s.Scan() {
+	}
+
+	var perr *ParseError
+
+	if !errors.As(s.Err(), &perr) || s.Record() != 2 || perr.Suggestion != "$1,234" {
+		t.Errorf("Expected record 2 to fail with a ParseError but got %d %v", s.Record(), s.Err())
+	}
+}
@@ -0,0 +1,173 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ScopeConfig configures a Scope
+type ScopeConfig struct {
+	// Defaults are the options of the scope, over the package builtins rather
+	// than the SetDefaults overrides
+	Defaults Options
+
+	// Currencies are the ISO codes the scope accepts, or all when empty
+	Currencies []string
+
+	// CashRounding is the step in minor units Round rounds to, 0 for none
+	CashRounding int64
+}
+
+// Scope holds the defaults, accepted currencies and rounding of one tenant, such
+// as a merchant of a SaaS platform, so tenants do not share the package level
+// defaults. A Scope is immutable and safe for concurrent use.
+type Scope struct {
+	options  Options
+	allowed  map[string]bool
+	rounding int64
+}
+
+// NewScope returns the Scope configured by config, failing for invalid options,
+// unknown currencies or a default currency the scope does not accept
+func NewScope(config ScopeConfig) (*Scope, error) {
+	noteDeprecated(config.Defaults)
+
+	if err := validate(config.Defaults); err != nil {
+		return nil, err
+	}
+
+	if config.CashRounding < 0 {
+		return nil, fmt.Errorf("money: invalid cash rounding %d", config.CashRounding)
+	}
+
+	s := &Scope{options: override(builtins(), config.Defaults), rounding: config.CashRounding}
+
+	if len(config.Currencies) > 0 {
+		s.allowed = map[string]bool{}
+
+		for _, code := range config.Currencies {
+			code = strings.ToUpper(code)
+
+			if _, ok := currencies[code]; !ok {
+				return nil, fmt.Errorf("%w %q", ErrUnknownCurrency, code)
+			}
+
+			s.allowed[code] = true
+		}
+	}
+
+	if code := s.options["currency"].(string); !s.Allows(code) {
+		return nil, fmt.Errorf("money: scope default currency %s is not accepted", code)
+	}
+
+	return s, nil
+}
+
+// Allows reports whether the scope accepts the currency
+func (s *Scope) Allows(code string) bool {
+	code = strings.ToUpper(code)
+
+	if _, ok := currencies[code]; !ok {
+		return false
+	}
+
+	return s.allowed == nil || s.allowed[code]
+}
+
+// Options returns the scope options overridden by opts
+func (s *Scope) Options(opts ...Options) Options {
+	options := override(Options{}, s.options)
+
+	if len(opts) > 0 {
+		noteDeprecated(opts[0])
+		options = override(options, opts[0])
+	}
+
+	return options
+}
+
+// Format is like Format with the scope options, failing for currencies the
+// scope does not accept
+func (s *Scope) Format(val float64, opts ...Options) (string, error) {
+	noteDeprecated(opts...)
+
+	options := s.Options(opts...)
+
+	code, _ := options["currency"].(string)
+
+	if err := s.check(code); err != nil {
+		return "", err
+	}
+
+	options["currency"] = strings.ToUpper(code)
+
+	if err := exactFloat(val, options["currency"].(string)); err != nil {
+		return "", err
+	}
+
+	return formatFloat(val, options), nil
+}
+
+// FormatMoney is like Money.Format with the scope options, failing for
+// currencies the scope does not accept
+func (s *Scope) FormatMoney(m Money, opts ...Options) (string, error) {
+	noteDeprecated(opts...)
+
+	if err := s.check(m.currency); err != nil {
+		return "", err
+	}
+
+	return m.Format(s.Options(opts...)), nil
+}
+
+// Parse is like Parse with the scope options, failing for currencies the scope
+// does not accept
+func (s *Scope) Parse(str string) (Money, error) {
+	m, err := Parse(str, s.options)
+
+	if err != nil {
+		return Money{}, err
+	}
+
+	if err := s.check(m.currency); err != nil {
+		return Money{}, err
+	}
+
+	return m, nil
+}
+
+// FromMinorUnits is like FromMinorUnits, failing for currencies the scope does
+// not accept
+func (s *Scope) FromMinorUnits(amount int64, code string) (Money, error) {
+	if err := s.check(code); err != nil {
+		return Money{}, err
+	}
+
+	return FromMinorUnits(amount, code)
+}
+
+// Round rounds m half away from zero to the scope cash rounding step
+func (s *Scope) Round(m Money) (Money, error) {
+	if err := s.check(m.currency); err != nil {
+		return Money{}, err
+	}
+
+	if s.rounding <= 1 {
+		return m, nil
+	}
+
+	return roundToStep(m, s.rounding)
+}
+
+// check fails for currencies the scope does not accept
+func (s *Scope) check(c string) error {
+	if _, ok := currencies[strings.ToUpper(c)]; !ok {
+		return fmt.Errorf("%w %q", ErrUnknownCurrency, c)
+	}
+
+	if !s.Allows(c) {
+		return fmt.Errorf("money: currency %s is not accepted in this scope", strings.ToUpper(c))
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestScope(t *testing.T) {
+	SetDefaults(Options{"with_currency": true})
+	defer SetDefaults(nil)
+
+	s, err := NewScope(ScopeConfig{Defaults: Options{"currency": "CHF"}, Currencies: []string{"CHF", "eur"}, CashRounding: 5})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result, err := s.Format(12.34); err != nil || result != "Fr12.34" {
+		t.Errorf("Expected Fr12.34 but got %s (%v)", result, err)
+	}
+
+	if result, err := s.Format(12.34, Options{"currency": "EUR", "with_cents": false}); err != nil || result != "€12" {
+		t.Errorf("Expected €12 but got %s (%v)", result, err)
+	}
+
+	if result, err := s.Format(12.34, Options{"currency": "eur"}); err != nil || result != "€12,34" {
+		t.Errorf("Expected €12,34 but got %s (%v)", result, err)
+	}
+
+	if result, err := s.FormatMoney(Money{1234, "EUR"}); err != nil || result != "€12,34" {
+		t.Errorf("Expected €12,34 but got %s (%v)", result, err)
+	}
+
+	if m, err := s.Parse("12.34"); err != nil || m != (Money{1234, "CHF"}) {
+		t.Errorf("Expected Fr12.34 but got %s (%v)", m, err)
+	}
+
+	if m, err := s.Round(Money{1233, "CHF"}); err != nil || m != (Money{1235, "CHF"}) {
+		t.Errorf("Expected Fr12.35 but got %s (%v)", m, err)
+	}
+
+	if result := Format(1); result != "$1.00 USD" {
+		t.Errorf("Expected the package defaults to be unaffected but got %s", result)
+	}
+}
+
+func TestScopeWhenNotAccepted(t *testing.T) {
+	s, _ := NewScope(ScopeConfig{Currencies: []string{"USD"}})
+
+	if _, err := s.Format(1, Options{"currency": "EUR"}); err == nil {
+		t.Error("Expected EUR to be rejected")
+	}
+
+	if _, err := s.FormatMoney(Money{1, "EUR"}); err == nil {
+		t.Error("Expected EUR to be rejected")
+	}
+
+	if _, err := s.Parse("€1,00"); err == nil {
+		t.Error("Expected EUR to be rejected")
+	}
+
+	if _, err := s.FromMinorUnits(1, "xyz"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+
+	if m, err := s.FromMinorUnits(1, "usd"); err != nil || m != (Money{1, "USD"}) {
+		t.Errorf("Expected $0.01 but got %s (%v)", m, err)
+	}
+
+	if !s.Allows("usd") || s.Allows("EUR") || s.Allows("XYZ") {
+		t.Error("Expected only USD to be accepted")
+	}
+}
+
+func TestNewScopeWhenInvalid(t *testing.T) {
+	invalid := []ScopeConfig{
+		{Defaults: Options{"with_cents": "no"}},
+		{Currencies: []string{"XYZ"}},
+		{Currencies: []string{"EUR"}},
+		{CashRounding: -1},
+	}
+
+	for _, c := range invalid {
+		if _, err := NewScope(c); err == nil {
+			t.Errorf("Expected %+v to be rejected", c)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package money
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// snapshotVersion is the version of the ExportConfig format
+const snapshotVersion = 1
+
+// snapshot is the package configuration exported by ExportConfig
+type snapshot struct {
+	Version       int                 `json:"version"`
+	Defaults      Options             `json:"defaults"`
+	DisplayOrder  []string            `json:"display_order"`
+	Formats       []string            `json:"formats"`
+	Jurisdictions []Jurisdiction      `json:"jurisdictions"`
+	Changeovers   []Changeover        `json:"changeovers"`
+	Currencies    map[string]currency `json:"currencies"`
+}
+
+// ExportConfig returns the package configuration as indented JSON, to review,
+// version or replicate with ImportConfig: the SetDefaults overrides, the currency
+// display order, the names of the registered formats, the jurisdictions with
+// their rounding and VAT rules, the changeovers and the currency registry. The
+// output only changes with the configuration.
+func ExportConfig() []byte {
+	s := snapshot{Version: snapshotVersion, Currencies: currencies}
+
+	settings.RLock()
+	s.Defaults = override(Options{}, settings.options)
+	settings.RUnlock()
+
+	s.DisplayOrder = displayOrderCodes()
+
+	formats.RLock()
+
+	for name := range formats.funcs {
+		s.Formats = append(s.Formats, name)
+	}
+
+	formats.RUnlock()
+
+	sort.Strings(s.Formats)
+
+	jurisdictions.RLock()
+
+	for _, j := range jurisdictions.rules {
+		j.Options = override(Options{}, j.Options)
+		s.Jurisdictions = append(s.Jurisdictions, j)
+	}
+
+	jurisdictions.RUnlock()
+
+	sort.Slice(s.Jurisdictions, func(i, j int) bool { return s.Jurisdictions[i].Code < s.Jurisdictions[j].Code })
+
+	changeovers.RLock()
+	s.Changeovers = append([]Changeover{}, changeovers.list...)
+	changeovers.RUnlock()
+
+	data, err := json.MarshalIndent(s, "", "  ")
+
+	if err != nil {
+		panic(fmt.Sprintf("money: cannot export config: %s", err))
+	}
+
+	return data
+}
+
+// ImportConfig applies configuration exported by ExportConfig. Formats cannot
+// be exported, so the named formats must already be registered, and the currency
+// registry must match. Jurisdictions and changeovers missing from the package
+// are registered; those already registered must match. All of this is checked
+// before any of it is applied, though registering can still fail, e.g. once the
+// dataset is frozen.
+func ImportConfig(data []byte) error {
+	if Frozen() {
+		return ErrFrozen
+	}
+
+	var s snapshot
+
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("money: invalid config: %s", err)
+	}
+
+	if s.Version != snapshotVersion {
+		return fmt.Errorf("money: unsupported config version %d", s.Version)
+	}
+
+	if err := validate(s.Defaults); err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(s.Currencies, currencies) {
+		return fmt.Errorf("money: config currencies do not match the registry")
+	}
+
+	for _, name := range s.Formats {
+		if _, ok := lookupFormat(name); !ok {
+			return fmt.Errorf("money: config format %q is not registered", name)
+		}
+	}
+
+	var missingJurisdictions []Jurisdiction
+	var missingChangeovers []Changeover
+
+	for _, j := range s.Jurisdictions {
+		registered, err := ForJurisdiction(j.Code)
+
+		switch j.Options = override(Options{}, j.Options); {
+		case err != nil:
+			missingJurisdictions = append(missingJurisdictions, j)
+		case !reflect.DeepEqual(j, registered):
+			return fmt.Errorf("money: config jurisdiction %s differs from the registered one", j.Code)
+		}
+	}
+
+	for _, c := range s.Changeovers {
+		switch registered, ok := registeredChangeover(c); {
+		case !ok:
+			missingChangeovers = append(missingChangeovers, c)
+		case registered.Rate != c.Rate || !registered.End.Equal(c.End):
+			return fmt.Errorf("money: config changeover %s differs from the registered one", Pair{c.Old, c.New})
+		}
+	}
+
+	if err := SetCurrencyDisplayOrder(s.DisplayOrder); err != nil {
+		return err
+	}
+
+	if err := SetDefaults(s.Defaults); err != nil {
+		return err
+	}
+
+	for _, j := range missingJurisdictions {
+		if err := RegisterJurisdiction(j); err != nil {
+			return err
+		}
+	}
+
+	for _, c := range missingChangeovers {
+		if err := RegisterChangeover(c); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// registeredChangeover returns the registered changeover between the currencies
+// of c starting at the same time
+func registeredChangeover(c Changeover) (Changeover, bool) {
+	changeovers.RLock()
+	defer changeovers.RUnlock()
+
+	for _, o := range changeovers.list {
+		if o.Old == c.Old && o.New == c.New && o.Start.Equal(c.Start) {
+			return o, true
+		}
+	}
+
+	return Changeover{}, false
+}
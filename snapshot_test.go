@@ -0,0 +1,98 @@
+package money
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// editConfig returns the exported config changed by edit
+func editConfig(t *testing.T, edit func(s *snapshot)) []byte {
+	var s snapshot
+
+	if err := json.Unmarshal(ExportConfig(), &s); err != nil {
+		t.Fatal(err)
+	}
+
+	edit(&s)
+	data, _ := json.Marshal(s)
+
+	return data
+}
+
+func TestExportConfig(t *testing.T) {
+	data := ExportConfig()
+
+	if !bytes.Equal(data, ExportConfig()) {
+		t.Error("Expected repeated exports to be identical")
+	}
+
+	for _, expected := range []string{`"version": 1`, `"Code": "CH"`, `"Old": "HRK"`, `"Name": "Swiss Franc"`} {
+		if !bytes.Contains(data, []byte(expected)) {
+			t.Errorf("Expected the config to contain %s", expected)
+		}
+	}
+
+	if err := ImportConfig(data); err != nil {
+		t.Errorf("Expected the exported config to import but got %s", err)
+	}
+}
+
+func TestImportConfig(t *testing.T) {
+	defer SetDefaults(nil)
+	defer SetCurrencyDisplayOrder(nil)
+
+	data := editConfig(t, func(s *snapshot) {
+		s.Defaults = Options{"currency": "EUR", "with_cents": false}
+		s.DisplayOrder = []string{"CHF"}
+		s.Jurisdictions = append(s.Jurisdictions, Jurisdiction{Code: "TEST-SNAP", Currency: "EUR", CashRounding: 5})
+	})
+
+	if err := ImportConfig(data); err != nil {
+		t.Fatal(err)
+	}
+
+	if s := Format(12.5); s != "€12" {
+		t.Errorf("Expected €12 but got %s", s)
+	}
+
+	if codes := CurrencyCodes(); codes[0] != "CHF" {
+		t.Errorf("Expected CHF first but got %s", codes[0])
+	}
+
+	if j, err := ForJurisdiction("TEST-SNAP"); err != nil || j.CashRounding != 5 {
+		t.Errorf("Expected TEST-SNAP to be registered but got %+v (%v)", j, err)
+	}
+
+	if err := ImportConfig(data); err != nil {
+		t.Errorf("Expected importing twice to succeed but got %s", err)
+	}
+}
+
+func TestImportConfigWhenInvalid(t *testing.T) {
+	values := map[string]func(s *snapshot){
+		"version":      func(s *snapshot) { s.Version = 2 },
+		"defaults":     func(s *snapshot) { s.Defaults = Options{"with_cents": "no"} },
+		"format":       func(s *snapshot) { s.Formats = append(s.Formats, "missing") },
+		"currencies":   func(s *snapshot) { delete(s.Currencies, "EUR") },
+		"jurisdiction": func(s *snapshot) { s.Jurisdictions[0].VATRate = 0.5 },
+		"changeover":   func(s *snapshot) { s.Changeovers[0].Rate = 7 },
+	}
+
+	before := ExportConfig()
+
+	for name, edit := range values {
+		if err := ImportConfig(editConfig(t, edit)); err == nil {
+			t.Errorf("Expected a config with an invalid %s to be rejected", name)
+		}
+	}
+
+	if err := ImportConfig([]byte("{")); err == nil || !strings.HasPrefix(err.Error(), "money: invalid config") {
+		t.Errorf("Expected invalid JSON to be rejected but got %v", err)
+	}
+
+	if !bytes.Equal(before, ExportConfig()) {
+		t.Error("Expected rejected configs to leave the configuration unchanged")
+	}
+}
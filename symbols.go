@@ -0,0 +1,77 @@
+package money
+
+import (
+	"sync"
+)
+
+// SymbolResolver chooses the symbol displayed for a currency, given its ISO code
+// and the registry symbol
+type SymbolResolver interface {
+	ResolveSymbol(code, symbol string) string
+}
+
+// SymbolResolverFunc adapts an ordinary function to a SymbolResolver
+type SymbolResolverFunc func(code, symbol string) string
+
+// ResolveSymbol calls f(code, symbol)
+func (f SymbolResolverFunc) ResolveSymbol(code, symbol string) string {
+	return f(code, symbol)
+}
+
+var resolver struct {
+	sync.RWMutex
+	r          SymbolResolver
+	generation uint64
+}
+
+// SetSymbolResolver installs r to pick the symbols used when formatting.
+// Passing nil restores the registry symbols. It fails once the dataset is frozen.
+// Formatting caches the symbols, so r must return the same symbol for a code
+// until it is replaced.
+func SetSymbolResolver(r SymbolResolver) error {
+	return unlessFrozen(func() error {
+		resolver.Lock()
+		resolver.r = r
+		resolver.generation++
+		resolver.Unlock()
+
+		resetLayouts()
+
+		return nil
+	})
+}
+
+// symbolGeneration counts the symbol resolvers set so far
+func symbolGeneration() uint64 {
+	resolver.RLock()
+	defer resolver.RUnlock()
+
+	return resolver.generation
+}
+
+// resolveSymbol returns the symbol to display for the currency
+func resolveSymbol(code string, c currency) string {
+	resolver.RLock()
+	defer resolver.RUnlock()
+
+	if resolver.r == nil {
+		return c.Symbol
+	}
+
+	return resolver.r.ResolveSymbol(code, c.Symbol)
+}
+
+// AmbiguousSymbols maps each registry symbol, primary or alternate, shared by
+// several currencies to the sorted codes of those currencies, e.g. "¥" to CNY
+// and JPY, for parsers and pickers to choose how to tell them apart
+func AmbiguousSymbols() map[string][]string {
+	ambiguous := map[string][]string{}
+
+	for symbol, owners := range symbolOwners() {
+		if len(owners) > 1 {
+			ambiguous[symbol] = append([]string(nil), owners...)
+		}
+	}
+
+	return ambiguous
+}
@@ -0,0 +1,67 @@
+package money
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSetSymbolResolver(t *testing.T) {
+	defer SetSymbolResolver(nil)
+
+	SetSymbolResolver(SymbolResolverFunc(func(code, symbol string) string {
+		if code == "USD" {
+			return "US$"
+		}
+
+		return symbol
+	}))
+
+	if currency := Format(10); currency != "US$10.00" {
+		t.Errorf("Expected US$10.00 but got %s", currency)
+	}
+
+	if currency := Format(10, Options{"currency": "CAD"}); currency != "$10.00" {
+		t.Errorf("Expected $10.00 but got %s", currency)
+	}
+
+	if currency := FormatIntl(-10); currency != "-US$10.00" {
+		t.Errorf("Expected -US$10.00 but got %s", currency)
+	}
+
+	if currency := FormatRuby(10); currency != "US$10.00" {
+		t.Errorf("Expected US$10.00 but got %s", currency)
+	}
+
+	SetSymbolResolver(nil)
+
+	if currency := Format(10); currency != "$10.00" {
+		t.Errorf("Expected registry symbol to be restored but got %s", currency)
+	}
+}
+
+func TestAmbiguousSymbols(t *testing.T) {
+	ambiguous := AmbiguousSymbols()
+	values := map[string][]string{"¥": {"CNY", "JPY"}, "C$": {"CAD", "NIO"}}
+
+	for symbol, expected := range values {
+		if !reflect.DeepEqual(ambiguous[symbol], expected) {
+			t.Errorf("Expected %s to be shared by %v but got %v", symbol, expected, ambiguous[symbol])
+		}
+	}
+
+	for _, symbol := range []string{"€", "CHF"} {
+		if owners, ok := ambiguous[symbol]; ok {
+			t.Errorf("Expected %s to be unambiguous but got %v", symbol, owners)
+		}
+	}
+
+	if owners := ambiguous["$"]; len(owners) < 2 || owners[0] > owners[1] {
+		t.Errorf("Expected $ to be shared by sorted currencies but got %v", owners)
+	}
+
+	ambiguous["¥"][0] = "XXX"
+
+	if AmbiguousSymbols()["¥"][0] != "CNY" {
+		t.Error("Expected the result to be a copy")
+	}
+}
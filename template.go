@@ -0,0 +1,122 @@
+package money
+
+import (
+	"sync"
+)
+
+// layouts caches the layout of each known currency and options combination
+var layouts sync.Map
+
+type layoutKey struct {
+	code       string
+	generation uint64
+	cents      bool
+	currency   bool
+	symbol     bool
+	space      bool
+	separator  bool
+}
+
+// Template formats Money with options interpreted once, for callers formatting
+// many amounts with the same options
+type Template struct {
+	code    string
+	options Options
+	fn      MoneyFormatFunc
+	l       layout
+}
+
+// NewTemplate returns a Template formatting with opts merged with the defaults
+func NewTemplate(opts ...Options) (t *Template) {
+	defer guard(nil)
+
+	options := defaults()
+
+	if len(opts) > 0 {
+		noteDeprecated(opts[0])
+		options = override(options, opts[0])
+	}
+
+	template := &Template{code: options["currency"].(string), options: options}
+
+	if fn, ok := lookupMoneyFormat(options["format"].(string)); ok {
+		template.options["format"] = ""
+		template.fn = fn
+	} else {
+		template.l = cachedLayout(options)
+	}
+
+	return template
+}
+
+// Format formats m as Money.Format does with the template options. For Money
+// in the template currency it allocates only the returned string.
+func (t *Template) Format(m Money) (result string) {
+	defer guard(nil)
+
+	if t.fn == nil && m.currency == t.code {
+		var buf [64]byte
+		return string(t.l.appendUnits(buf[:0], m.amount))
+	}
+
+	options := override(Options{}, t.options)
+	options["currency"] = m.currency
+
+	if t.fn != nil {
+		return t.fn(m, options)
+	}
+
+	return string(cachedLayout(options).appendUnits(nil, m.amount))
+}
+
+// AppendFormat appends m formatted as Format does to dst. For Money in the
+// template currency it does not allocate once dst has room for the result.
+func (t *Template) AppendFormat(dst []byte, m Money) (result []byte) {
+	defer guard(nil)
+
+	if t.fn == nil && m.currency == t.code {
+		return t.l.appendUnits(dst, m.amount)
+	}
+
+	return append(dst, t.Format(m)...)
+}
+
+// cachedLayout returns the layout of options merged with the defaults, compiling
+// it once per known currency and options combination
+func cachedLayout(options Options) layout {
+	code := options["currency"].(string)
+
+	if _, ok := currencies[code]; !ok {
+		return newLayout(options)
+	}
+
+	key := layoutKey{
+		code:       code,
+		generation: symbolGeneration(),
+		cents:      options["with_cents"].(bool),
+		currency:   options["with_currency"].(bool),
+		symbol:     options["with_symbol"].(bool),
+		space:      options["with_symbol_space"].(bool),
+		separator:  options["with_thousands_separator"].(bool),
+	}
+
+	if l, ok := layouts.Load(key); ok {
+		return l.(layout)
+	}
+
+	l := newLayout(options)
+
+	if key.generation == symbolGeneration() {
+		layouts.Store(key, l)
+	}
+
+	return l
+}
+
+// resetLayouts drops the cached layouts, e.g. after the symbols changed
+func resetLayouts() {
+	layouts.Range(func(key, _ interface{}) bool {
+		layouts.Delete(key)
+		return true
+	})
+}
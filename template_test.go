@@ -0,0 +1,87 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestTemplate(t *testing.T) {
+	tmpl := NewTemplate(Options{"currency": "EUR", "with_currency": true})
+
+	values := []struct {
+		m        Money
+		expected string
+	}{
+		{Money{123456, "EUR"}, "€1.234,56 EUR"},
+		{Money{-5, "EUR"}, "-€0,05 EUR"},
+		{Money{1050, "USD"}, "$10.50 USD"},
+	}
+
+	for _, v := range values {
+		if s := tmpl.Format(v.m); s != v.expected {
+			t.Errorf("Expected %s but got %s", v.expected, s)
+		}
+	}
+}
+
+func TestTemplateWithRegisteredFormat(t *testing.T) {
+	RegisterFormat("template-code", func(val float64, opts Options) string {
+		return opts["currency"].(string)
+	})
+
+	if s := NewTemplate(Options{"format": "template-code"}).Format(Money{1, "JPY"}); s != "JPY" {
+		t.Errorf("Expected JPY but got %s", s)
+	}
+}
+
+func TestCachedLayoutFollowsSymbolResolver(t *testing.T) {
+	defer SetSymbolResolver(nil)
+
+	if s := (Money{1050, "USD"}).Format(); s != "$10.50" {
+		t.Fatalf("Expected $10.50 but got %s", s)
+	}
+
+	SetSymbolResolver(SymbolResolverFunc(func(code, symbol string) string { return "US$" }))
+
+	if s := (Money{1050, "USD"}).Format(); s != "US$10.50" {
+		t.Errorf("Expected US$10.50 but got %s", s)
+	}
+}
+
+func BenchmarkMoneyFormat(b *testing.B) {
+	m := Money{123456789, "EUR"}
+
+	for i := 0; i < b.N; i++ {
+		m.Format(Options{"with_currency": true})
+	}
+}
+
+func BenchmarkTemplateFormat(b *testing.B) {
+	m := Money{123456789, "EUR"}
+	tmpl := NewTemplate(Options{"currency": "EUR", "with_currency": true})
+
+	for i := 0; i < b.N; i++ {
+		tmpl.Format(m)
+	}
+}
+
+func TestTemplateAllocations(t *testing.T) {
+	m := Money{-123456789, "EUR"}
+	tmpl := NewTemplate(Options{"currency": "EUR", "with_currency": true, "with_symbol_space": true})
+	buf := make([]byte, 0, 64)
+
+	if n := testing.AllocsPerRun(100, func() { buf = tmpl.AppendFormat(buf[:0], m) }); n != 0 {
+		t.Errorf("Expected AppendFormat not to allocate but got %v allocations", n)
+	}
+
+	if n := testing.AllocsPerRun(100, func() { tmpl.Format(m) }); n != 1 {
+		t.Errorf("Expected Format to allocate only its result but got %v allocations", n)
+	}
+
+	if s := string(tmpl.AppendFormat([]byte("total: "), m)); s != "total: -€ 1.234.567,89 EUR" {
+		t.Errorf("Expected total: -€ 1.234.567,89 EUR but got %s", s)
+	}
+
+	if s := string(tmpl.AppendFormat(nil, Money{100, "USD"})); s != "$ 1.00 USD" {
+		t.Errorf("Expected $ 1.00 USD but got %s", s)
+	}
+}
@@ -0,0 +1,138 @@
+package money
+
+import (
+	"fmt"
+	"math"
+)
+
+// maxTenders bounds the tenders PlanTender searches combinations of
+const maxTenders = 16
+
+// TenderLimit bounds the amount charged to one tender, such as a gift card, points
+// or a card. A tender is either unused or charged between Min and Max; a Min
+// without currency is zero and a Max without currency leaves the tender unlimited.
+type TenderLimit struct {
+	Name string
+	Min  Money
+	Max  Money
+}
+
+// Tender is the amount planned for one tender
+type Tender struct {
+	Name   string
+	Amount Money
+}
+
+// PlanTender allocates total across tenders, charging each tender in order as
+// much as its limits and those of the tenders after it allow
+func PlanTender(total Money, tenders []TenderLimit) ([]Tender, error) {
+	if total.IsNegative() {
+		return nil, fmt.Errorf("money: cannot plan tenders for %s", total)
+	}
+
+	if len(tenders) > maxTenders {
+		return nil, fmt.Errorf("money: cannot plan more than %d tenders", maxTenders)
+	}
+
+	limits := make([][2]int64, len(tenders))
+	var available uint64
+
+	for i, t := range tenders {
+		limits[i] = [2]int64{t.Min.amount, math.MaxInt64}
+
+		for _, m := range []Money{t.Min, t.Max} {
+			if m.currency != "" {
+				if err := total.sameCurrency(m); err != nil {
+					return nil, fmt.Errorf("%w for tender %s", err, t.Name)
+				}
+			}
+		}
+
+		if t.Max.currency != "" {
+			limits[i][1] = t.Max.amount
+		}
+
+		if limits[i][0] < 0 || limits[i][0] > limits[i][1] {
+			return nil, fmt.Errorf("money: tender %s has invalid limits %s to %s", t.Name, t.Min, t.Max)
+		}
+
+		if available += uint64(limits[i][1]); available > math.MaxInt64 {
+			available = math.MaxInt64
+		}
+	}
+
+	var best []int64
+
+	for used := 0; used < 1<<len(tenders); used++ {
+		if plan := planTenders(total.amount, limits, used); plan != nil && preferPlan(plan, best) {
+			best = plan
+		}
+	}
+
+	if best == nil {
+		if available < uint64(total.amount) {
+			return nil, fmt.Errorf("money: tenders cover at most %s of %s", Money{int64(available), total.currency}, total)
+		}
+
+		return nil, fmt.Errorf("money: no combination of tenders meets their minimums for %s", total)
+	}
+
+	plan := make([]Tender, len(tenders))
+
+	for i, t := range tenders {
+		plan[i] = Tender{t.Name, Money{best[i], total.currency}}
+	}
+
+	return plan, nil
+}
+
+// planTenders charges the tenders in the used bit set their minimum, then fills
+// them in order up to their maximum, returning nil when they cannot add up to total
+func planTenders(total int64, limits [][2]int64, used int) []int64 {
+	plan := make([]int64, len(limits))
+	remaining := total
+
+	for i, l := range limits {
+		if used&(1<<i) != 0 {
+			if plan[i] = l[0]; remaining < l[0] {
+				return nil
+			}
+
+			remaining -= l[0]
+		}
+	}
+
+	for i, l := range limits {
+		if used&(1<<i) != 0 {
+			extra := l[1] - l[0]
+
+			if extra > remaining {
+				extra = remaining
+			}
+
+			plan[i] += extra
+			remaining -= extra
+		}
+	}
+
+	if remaining != 0 {
+		return nil
+	}
+
+	return plan
+}
+
+// preferPlan reports whether plan charges earlier tenders more than best
+func preferPlan(plan, best []int64) bool {
+	if best == nil {
+		return true
+	}
+
+	for i := range plan {
+		if plan[i] != best[i] {
+			return plan[i] > best[i]
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,62 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPlanTender(t *testing.T) {
+	usd := func(amount int64) Money { return Money{amount, "USD"} }
+
+	values := []struct {
+		total    Money
+		tenders  []TenderLimit
+		expected []int64
+	}{
+		{usd(10000), []TenderLimit{{"gift card", Money{}, usd(3000)}, {"points", usd(1000), usd(2000)}, {"card", Money{}, Money{}}}, []int64{3000, 2000, 5000}},
+		{usd(3500), []TenderLimit{{"gift card", Money{}, usd(3000)}, {"points", usd(1000), usd(2000)}, {"card", Money{}, Money{}}}, []int64{3000, 0, 500}},
+		{usd(3500), []TenderLimit{{"gift card", Money{}, usd(3000)}, {"points", usd(1000), usd(2000)}}, []int64{2500, 1000}},
+		{usd(3500), []TenderLimit{{"gift card", Money{}, usd(3000)}, {"card", usd(1000), Money{}}}, []int64{2500, 1000}},
+		{usd(0), []TenderLimit{{"card", usd(1000), Money{}}}, []int64{0}},
+	}
+
+	for _, v := range values {
+		plan, err := PlanTender(v.total, v.tenders)
+
+		if err != nil {
+			t.Errorf("Expected %s to be planned but got %s", v.total, err)
+			continue
+		}
+
+		for i, tender := range plan {
+			if tender.Name != v.tenders[i].Name || tender.Amount != usd(v.expected[i]) {
+				t.Errorf("Expected %s of %s to be %d but got %s", tender.Name, v.total, v.expected[i], tender.Amount)
+			}
+		}
+	}
+}
+
+func TestPlanTenderWhenInvalid(t *testing.T) {
+	usd := func(amount int64) Money { return Money{amount, "USD"} }
+
+	values := []struct {
+		total    Money
+		tenders  []TenderLimit
+		expected string
+	}{
+		{usd(10000), []TenderLimit{{"gift card", Money{}, usd(3000)}}, "money: tenders cover at most $30.00 of $100.00"},
+		{usd(500), []TenderLimit{{"points", usd(1000), usd(2000)}}, "money: no combination of tenders meets their minimums for $5.00"},
+		{usd(500), []TenderLimit{{"card", usd(2000), usd(1000)}}, "money: tender card has invalid limits $20.00 to $10.00"},
+		{usd(-500), nil, "money: cannot plan tenders for -$5.00"},
+	}
+
+	for _, v := range values {
+		if _, err := PlanTender(v.total, v.tenders); err == nil || err.Error() != v.expected {
+			t.Errorf("Expected %s but got %v", v.expected, err)
+		}
+	}
+
+	if _, err := PlanTender(usd(500), []TenderLimit{{"card", Money{}, Money{1000, "EUR"}}}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+}
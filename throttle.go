@@ -0,0 +1,173 @@
+package money
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ContextRateProvider is a RateProvider whose calls can be cancelled, such as
+// one fetching rates over HTTP
+type ContextRateProvider interface {
+	RateContext(ctx context.Context, from, to string) (float64, error)
+}
+
+// RetryPolicy configures a Throttled provider. Zero fields disable the feature
+// they configure.
+type RetryPolicy struct {
+	// Attempts is the number of calls made for a rate, once when zero
+	Attempts int
+
+	// Backoff is the wait before the second attempt, doubling for each further
+	// one up to MaxBackoff
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+
+	// Timeout bounds each attempt of a ContextRateProvider
+	Timeout time.Duration
+
+	// PerSecond limits the calls to the provider, allowing bursts of Burst calls,
+	// one when zero
+	PerSecond float64
+	Burst     int
+}
+
+// Throttled wraps a remote RateProvider with retries, exponential backoff and
+// rate limiting. Failures with ErrNoRate are not retried. It is safe for
+// concurrent use, and is a ContextRateProvider whose context cancels retries
+// and waits, and is passed on to a ContextRateProvider.
+type Throttled struct {
+	provider RateProvider
+	policy   RetryPolicy
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// NewThrottled returns provider with policy, failing for negative fields
+func NewThrottled(provider RateProvider, policy RetryPolicy) (*Throttled, error) {
+	if policy.Attempts < 0 || policy.Backoff < 0 || policy.MaxBackoff < 0 || policy.Timeout < 0 || !(policy.PerSecond >= 0) || policy.Burst < 0 {
+		return nil, fmt.Errorf("money: invalid retry policy %+v", policy)
+	}
+
+	if policy.Attempts == 0 {
+		policy.Attempts = 1
+	}
+
+	if policy.Burst == 0 {
+		policy.Burst = 1
+	}
+
+	return &Throttled{provider: provider, policy: policy, tokens: float64(policy.Burst), now: time.Now, sleep: sleepContext}, nil
+}
+
+// Rate is RateContext with a background context
+func (t *Throttled) Rate(from, to string) (float64, error) {
+	return t.RateContext(context.Background(), from, to)
+}
+
+// RateContext returns the rate of the provider, retrying failures other than
+// ErrNoRate. It fails with the error of the last attempt, or with the error of
+// ctx when it is done.
+func (t *Throttled) RateContext(ctx context.Context, from, to string) (float64, error) {
+	backoff := t.policy.Backoff
+	var err error
+
+	for attempt := 0; attempt < t.policy.Attempts; attempt++ {
+		if attempt > 0 && backoff > 0 {
+			if err := t.sleep(ctx, backoff); err != nil {
+				return 0, err
+			}
+
+			if backoff *= 2; t.policy.MaxBackoff > 0 && backoff > t.policy.MaxBackoff {
+				backoff = t.policy.MaxBackoff
+			}
+		}
+
+		if err := t.wait(ctx); err != nil {
+			return 0, err
+		}
+
+		var rate float64
+
+		if rate, err = t.call(ctx, from, to); err == nil || errors.Is(err, ErrNoRate) {
+			return rate, err
+		}
+	}
+
+	return 0, err
+}
+
+// call makes an attempt, bounded by the timeout of the policy
+func (t *Throttled) call(ctx context.Context, from, to string) (float64, error) {
+	p, ok := t.provider.(ContextRateProvider)
+
+	if !ok {
+		return t.provider.Rate(from, to)
+	}
+
+	if t.policy.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, t.policy.Timeout)
+		defer cancel()
+	}
+
+	return p.RateContext(ctx, from, to)
+}
+
+// wait takes a token of the rate limiter, waiting for one when there is none
+func (t *Throttled) wait(ctx context.Context) error {
+	if t.policy.PerSecond == 0 {
+		return ctx.Err()
+	}
+
+	t.mu.Lock()
+	now := t.now()
+
+	if !t.last.IsZero() {
+		t.tokens += now.Sub(t.last).Seconds() * t.policy.PerSecond
+
+		if t.tokens > float64(t.policy.Burst) {
+			t.tokens = float64(t.policy.Burst)
+		}
+	}
+
+	// the token is taken now, and is owed until the wait is over
+	t.last = now
+	t.tokens--
+	delay := time.Duration(-t.tokens / t.policy.PerSecond * float64(time.Second))
+	t.mu.Unlock()
+
+	if delay <= 0 {
+		return ctx.Err()
+	}
+
+	if err := t.sleep(ctx, delay); err != nil {
+		t.mu.Lock()
+		t.tokens++
+		t.mu.Unlock()
+
+		return err
+	}
+
+	return nil
+}
+
+// sleepContext waits for d, failing with the error of ctx when it is done first
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
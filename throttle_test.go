@@ -0,0 +1,138 @@
+package money
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// fakeTime is a clock advanced by the waits it records
+type fakeTime struct {
+	now   time.Time
+	waits []time.Duration
+}
+
+func (c *fakeTime) install(t *Throttled) {
+	t.now = func() time.Time { return c.now }
+	t.sleep = func(ctx context.Context, d time.Duration) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		c.waits = append(c.waits, d)
+		c.now = c.now.Add(d)
+
+		return nil
+	}
+}
+
+func TestThrottledRetries(t *testing.T) {
+	failures := 3
+	calls := 0
+
+	provider := RateProviderFunc(func(from, to string) (float64, error) {
+		if calls++; calls <= failures {
+			return 0, errors.New("503 Service Unavailable")
+		}
+
+		return 1.08, nil
+	})
+
+	th, err := NewThrottled(provider, RetryPolicy{Attempts: 5, Backoff: 100 * time.Millisecond, MaxBackoff: 300 * time.Millisecond})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clock := &fakeTime{now: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)}
+	clock.install(th)
+
+	if rate, err := th.Rate("EUR", "USD"); err != nil || rate != 1.08 {
+		t.Errorf("Expected 1.08 but got %v %v", rate, err)
+	}
+
+	if expected := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 300 * time.Millisecond}; !reflect.DeepEqual(clock.waits, expected) {
+		t.Errorf("Expected the backoffs %v but got %v", expected, clock.waits)
+	}
+
+	calls, failures = 0, 10
+
+	if _, err := th.Rate("EUR", "USD"); err == nil || calls != 5 {
+		t.Errorf("Expected 5 failed attempts but got %d %v", calls, err)
+	}
+}
+
+func TestThrottledDoesNotRetryMissingRates(t *testing.T) {
+	calls := 0
+	th, _ := NewThrottled(RateProviderFunc(func(from, to string) (float64, error) {
+		calls++
+		return 0, ErrNoRate
+	}), RetryPolicy{Attempts: 3})
+
+	if _, err := th.Rate("EUR", "XAU"); !errors.Is(err, ErrNoRate) || calls != 1 {
+		t.Errorf("Expected a single call failing with ErrNoRate but got %d %v", calls, err)
+	}
+}
+
+func TestThrottledRateLimit(t *testing.T) {
+	th, _ := NewThrottled(RateProviderFunc(func(from, to string) (float64, error) { return 1, nil }), RetryPolicy{PerSecond: 2, Burst: 2})
+	clock := &fakeTime{now: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)}
+	clock.install(th)
+
+	for i := 0; i < 4; i++ {
+		th.Rate("EUR", "USD")
+	}
+
+	if expected := []time.Duration{500 * time.Millisecond, 500 * time.Millisecond}; !reflect.DeepEqual(clock.waits, expected) {
+		t.Errorf("Expected the burst then a wait per call but got %v", clock.waits)
+	}
+
+	clock.now = clock.now.Add(time.Hour)
+	clock.waits = nil
+	th.Rate("EUR", "USD")
+
+	if len(clock.waits) != 0 {
+		t.Errorf("Expected the tokens to refill but got %v", clock.waits)
+	}
+}
+
+// contextRates records the deadline of its calls
+type contextRates struct {
+	deadline bool
+}
+
+func (p *contextRates) Rate(from, to string) (float64, error) {
+	return 0, errors.New("not called")
+}
+
+func (p *contextRates) RateContext(ctx context.Context, from, to string) (float64, error) {
+	_, p.deadline = ctx.Deadline()
+
+	return 1.5, ctx.Err()
+}
+
+func TestThrottledContext(t *testing.T) {
+	p := &contextRates{}
+	th, _ := NewThrottled(p, RetryPolicy{Attempts: 2, Backoff: time.Second, Timeout: time.Second})
+
+	if rate, err := th.RateContext(context.Background(), "EUR", "USD"); err != nil || rate != 1.5 || !p.deadline {
+		t.Errorf("Expected a call with a deadline but got %v %v %v", rate, err, p.deadline)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := th.RateContext(ctx, "EUR", "USD"); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled but got %v", err)
+	}
+
+	if err := sleepContext(ctx, time.Hour); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the wait to be cancelled but got %v", err)
+	}
+
+	if _, err := NewThrottled(p, RetryPolicy{Attempts: -1}); err == nil {
+		t.Error("Expected an invalid policy to be rejected")
+	}
+}
@@ -0,0 +1,203 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// maxChangeSteps bounds the combinations of denominations RemoveChange tries
+const maxChangeSteps = 1 << 16
+
+// TillCount is a number of notes or coins of a denomination
+type TillCount struct {
+	Denomination Money
+	Count        int64
+}
+
+// Till counts the notes and coins of a cash drawer by denomination. It is not
+// safe for concurrent use.
+type Till struct {
+	currency string
+	counts   map[int64]int64
+}
+
+// NewTill returns an empty Till for the currency
+func NewTill(code string) (*Till, error) {
+	code = strings.ToUpper(code)
+
+	if _, ok := currencies[code]; !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownCurrency, code)
+	}
+
+	return &Till{code, map[int64]int64{}}, nil
+}
+
+// AddCount adds n notes or coins of denomination, failing for denominations in
+// another currency or not positive, and counts which are negative or would
+// exceed the total the till can hold
+func (t *Till) AddCount(denomination Money, n int64) error {
+	if err := t.checkDenomination(denomination); err != nil {
+		return err
+	}
+
+	if n < 0 {
+		return fmt.Errorf("money: cannot add %d of %s to a till", n, denomination)
+	}
+
+	total, err := t.Total()
+
+	if err != nil {
+		return err
+	}
+
+	if n > (math.MaxInt64-total.amount)/denomination.amount {
+		return fmt.Errorf("%w: %d of %s in a till of %s", ErrOverflow, n, denomination, total)
+	}
+
+	if n > 0 {
+		t.counts[denomination.amount] += n
+	}
+
+	return nil
+}
+
+// Count returns the number of notes or coins of denomination
+func (t *Till) Count(denomination Money) int64 {
+	if denomination.currency != t.currency {
+		return 0
+	}
+
+	return t.counts[denomination.amount]
+}
+
+// Counts returns the counts of the till by decreasing denomination
+func (t *Till) Counts() []TillCount {
+	counts := make([]TillCount, 0, len(t.counts))
+
+	for _, d := range t.denominations() {
+		counts = append(counts, TillCount{Money{d, t.currency}, t.counts[d]})
+	}
+
+	return counts
+}
+
+// Total returns the value of the till
+func (t *Till) Total() (Money, error) {
+	var total int64
+
+	for d, n := range t.counts {
+		if n > (math.MaxInt64-total)/d {
+			return Money{}, fmt.Errorf("%w: total of a till", ErrOverflow)
+		}
+
+		total += d * n
+	}
+
+	return Money{total, t.currency}, nil
+}
+
+// Variance returns the total of the till less expected, an overage when
+// positive and a shortage when negative
+func (t *Till) Variance(expected Money) (Money, error) {
+	total, err := t.Total()
+
+	if err != nil {
+		return Money{}, err
+	}
+
+	return total.Subtract(expected)
+}
+
+// RemoveChange takes amount out of the till, preferring the largest notes and
+// coins, and returns them by decreasing denomination. It fails, leaving the
+// till as it was, when the counts cannot make amount exactly.
+func (t *Till) RemoveChange(amount Money) ([]TillCount, error) {
+	if err := (Money{currency: t.currency}).sameCurrency(amount); err != nil {
+		return nil, err
+	}
+
+	if amount.IsNegative() {
+		return nil, fmt.Errorf("money: cannot remove %s from a till", amount)
+	}
+
+	denominations := t.denominations()
+	taken := make([]int64, len(denominations))
+	steps := 0
+
+	// depth first over the denominations, taking as many of each as fit first
+	var search func(i int, left int64) bool
+	search = func(i int, left int64) bool {
+		if left == 0 {
+			return true
+		}
+
+		if i == len(denominations) || steps >= maxChangeSteps {
+			return false
+		}
+
+		steps++
+		d := denominations[i]
+		n := left / d
+
+		if n > t.counts[d] {
+			n = t.counts[d]
+		}
+
+		for ; n >= 0; n-- {
+			if taken[i] = n; search(i+1, left-n*d) {
+				return true
+			}
+		}
+
+		taken[i] = 0
+
+		return false
+	}
+
+	if !search(0, amount.amount) {
+		return nil, fmt.Errorf("money: till cannot make change of %s", amount)
+	}
+
+	var change []TillCount
+
+	for i, d := range denominations {
+		if taken[i] > 0 {
+			t.counts[d] -= taken[i]
+			change = append(change, TillCount{Money{d, t.currency}, taken[i]})
+
+			if t.counts[d] == 0 {
+				delete(t.counts, d)
+			}
+		}
+	}
+
+	return change, nil
+}
+
+// checkDenomination ensures denomination is a positive amount in the currency of the till
+func (t *Till) checkDenomination(denomination Money) error {
+	if err := (Money{currency: t.currency}).sameCurrency(denomination); err != nil {
+		return err
+	}
+
+	if !denomination.IsPositive() {
+		return fmt.Errorf("money: invalid denomination %s", denomination)
+	}
+
+	return nil
+}
+
+// denominations returns the denominations of the till, largest first
+func (t *Till) denominations() []int64 {
+	denominations := make([]int64, 0, len(t.counts))
+
+	for d := range t.counts {
+		denominations = append(denominations, d)
+	}
+
+	sort.Slice(denominations, func(i, j int) bool { return denominations[i] > denominations[j] })
+
+	return denominations
+}
@@ -0,0 +1,84 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestTill(t *testing.T) {
+	till, err := NewTill("usd")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for d, n := range map[int64]int64{2000: 2, 500: 3, 100: 4, 25: 4, 10: 3} {
+		if err := till.AddCount(Money{d, "USD"}, n); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if total, err := till.Total(); err != nil || total != (Money{6030, "USD"}) {
+		t.Errorf("Expected $60.30 but got %s %v", total, err)
+	}
+
+	if v, err := till.Variance(Money{6100, "USD"}); err != nil || v != (Money{-70, "USD"}) {
+		t.Errorf("Expected a shortage of $0.70 but got %s %v", v, err)
+	}
+
+	// greedily $0.25 then five cents short; backtracking uses three dimes
+	change, err := till.RemoveChange(Money{630, "USD"})
+	expected := []TillCount{{Money{500, "USD"}, 1}, {Money{100, "USD"}, 1}, {Money{10, "USD"}, 3}}
+
+	if err != nil || !reflect.DeepEqual(change, expected) {
+		t.Errorf("Expected %v but got %v %v", expected, change, err)
+	}
+
+	if n := till.Count(Money{10, "USD"}); n != 0 {
+		t.Errorf("Expected no dimes left but got %d", n)
+	}
+
+	if _, err := till.RemoveChange(Money{5, "USD"}); err == nil {
+		t.Error("Expected change without nickels to be rejected")
+	}
+
+	if total, _ := till.Total(); total != (Money{5400, "USD"}) {
+		t.Errorf("Expected a failed removal to leave $54.00 but got %s", total)
+	}
+
+	counts := []TillCount{{Money{2000, "USD"}, 2}, {Money{500, "USD"}, 2}, {Money{100, "USD"}, 3}, {Money{25, "USD"}, 4}}
+
+	if got := till.Counts(); !reflect.DeepEqual(got, counts) {
+		t.Errorf("Expected %v but got %v", counts, got)
+	}
+}
+
+func TestTillWhenInvalid(t *testing.T) {
+	if _, err := NewTill("XXX"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+
+	till, _ := NewTill("EUR")
+
+	if err := till.AddCount(Money{100, "USD"}, 1); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+
+	if err := till.AddCount(Money{0, "EUR"}, 1); err == nil {
+		t.Error("Expected a zero denomination to be rejected")
+	}
+
+	if err := till.AddCount(Money{100, "EUR"}, -1); err == nil {
+		t.Error("Expected a negative count to be rejected")
+	}
+
+	if err := till.AddCount(Money{100, "EUR"}, math.MaxInt64/50); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow but got %v", err)
+	}
+
+	if _, err := till.RemoveChange(Money{-1, "EUR"}); err == nil {
+		t.Error("Expected a negative amount to be rejected")
+	}
+}
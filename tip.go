@@ -0,0 +1,76 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// TipRounding selects how Tip rounds a tip or a service charge
+type TipRounding int
+
+const (
+	// TipExact rounds the tip half away from zero to minor units
+	TipExact TipRounding = iota
+
+	// TipRoundedUp rounds the tip up to a whole unit of the currency, e.g. $4.11
+	// to $5.00
+	TipRoundedUp
+
+	// TotalRoundedUp rounds the total up to a whole unit of the currency, the
+	// tip being what it adds to the bill, e.g. $4.11 on $27.40 to $4.60
+	TotalRoundedUp
+)
+
+// Tip returns percent of bill as a tip or a service charge, rounded by
+// rounding, and the total with it, failing for negative bills and invalid
+// percentages
+func Tip(bill Money, percent float64, rounding TipRounding) (tip, total Money, err error) {
+	if _, ok := currencies[bill.currency]; !ok {
+		return Money{}, Money{}, fmt.Errorf("%w %q", ErrUnknownCurrency, bill.currency)
+	}
+
+	if bill.IsNegative() {
+		return Money{}, Money{}, fmt.Errorf("money: cannot tip on %s", bill)
+	}
+
+	if !(percent >= 0) || math.IsInf(percent, 0) {
+		return Money{}, Money{}, fmt.Errorf("money: invalid tip of %v%%", percent)
+	}
+
+	exact := new(big.Rat).Mul(new(big.Rat).SetInt64(bill.amount), decimalRat(percent))
+	units, ok := roundRat(exact.Quo(exact, big.NewRat(100, 1)))
+
+	if !ok {
+		return Money{}, Money{}, fmt.Errorf("%w: %v%% of %s", ErrOverflow, percent, bill)
+	}
+
+	tip = Money{units, bill.currency}
+	unit := Money{currencies[bill.currency].units(), bill.currency}
+
+	switch rounding {
+	case TipExact:
+	case TipRoundedUp:
+		if tip, _, err = RoundUpTo(tip, unit); err != nil {
+			return Money{}, Money{}, err
+		}
+	case TotalRoundedUp:
+		if total, err = bill.Add(tip); err != nil {
+			return Money{}, Money{}, err
+		}
+
+		if total, _, err = RoundUpTo(total, unit); err != nil {
+			return Money{}, Money{}, err
+		}
+
+		return Money{total.amount - bill.amount, bill.currency}, total, nil
+	default:
+		return Money{}, Money{}, fmt.Errorf("money: invalid tip rounding %d", rounding)
+	}
+
+	if total, err = bill.Add(tip); err != nil {
+		return Money{}, Money{}, err
+	}
+
+	return tip, total, nil
+}
@@ -0,0 +1,53 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestTip(t *testing.T) {
+	values := []struct {
+		bill       Money
+		percent    float64
+		rounding   TipRounding
+		tip, total Money
+	}{
+		{Money{2700, "USD"}, 15, TipExact, Money{405, "USD"}, Money{3105, "USD"}},
+		{Money{2700, "USD"}, 15, TipRoundedUp, Money{500, "USD"}, Money{3200, "USD"}},
+		{Money{2740, "USD"}, 15, TipRoundedUp, Money{500, "USD"}, Money{3240, "USD"}},
+		{Money{2740, "USD"}, 15, TotalRoundedUp, Money{460, "USD"}, Money{3200, "USD"}},
+		{Money{2000, "USD"}, 20, TotalRoundedUp, Money{400, "USD"}, Money{2400, "USD"}},
+		{Money{1999, "USD"}, 12.5, TipExact, Money{250, "USD"}, Money{2249, "USD"}},
+		{Money{4480, "JPY"}, 10, TipRoundedUp, Money{448, "JPY"}, Money{4928, "JPY"}},
+		{Money{0, "USD"}, 18, TotalRoundedUp, Money{0, "USD"}, Money{0, "USD"}},
+	}
+
+	for _, v := range values {
+		tip, total, err := Tip(v.bill, v.percent, v.rounding)
+
+		if err != nil || tip != v.tip || total != v.total {
+			t.Errorf("Expected %s and %s on %s but got %s %s %v", v.tip, v.total, v.bill, tip, total, err)
+		}
+	}
+}
+
+func TestTipWhenInvalid(t *testing.T) {
+	for _, percent := range []float64{-1, math.NaN(), math.Inf(1)} {
+		if _, _, err := Tip(Money{100, "USD"}, percent, TipExact); err == nil {
+			t.Errorf("Expected %v%% to be rejected", percent)
+		}
+	}
+
+	if _, _, err := Tip(Money{-100, "USD"}, 10, TipExact); err == nil {
+		t.Error("Expected a negative bill to be rejected")
+	}
+
+	if _, _, err := Tip(Money{100, "USD"}, 10, TipRounding(9)); err == nil {
+		t.Error("Expected an invalid rounding to be rejected")
+	}
+
+	if _, _, err := Tip(Money{math.MaxInt64, "USD"}, 10, TipExact); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow but got %v", err)
+	}
+}
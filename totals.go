@@ -0,0 +1,151 @@
+package money
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var displayOrder struct {
+	sync.RWMutex
+	ranks map[string]int
+}
+
+// SetCurrencyDisplayOrder puts the currencies in codes first, in that order,
+// wherever currencies are listed for display, e.g. the home currency ahead of
+// the others. Other currencies follow in code order. Passing nil restores plain
+// code order.
+func SetCurrencyDisplayOrder(codes []string) error {
+	ranks := make(map[string]int, len(codes))
+
+	for i, code := range codes {
+		code = strings.ToUpper(code)
+
+		if _, ok := currencies[code]; !ok {
+			return fmt.Errorf("%w %q", ErrUnknownCurrency, code)
+		}
+
+		if _, ok := ranks[code]; ok {
+			return fmt.Errorf("money: currency %s is listed twice", code)
+		}
+
+		ranks[code] = i
+	}
+
+	return unlessFrozen(func() error {
+		displayOrder.Lock()
+		defer displayOrder.Unlock()
+
+		displayOrder.ranks = ranks
+
+		return nil
+	})
+}
+
+// CurrencyCodes returns the codes of the registered currencies in display order,
+// e.g. to fill a currency picker
+func CurrencyCodes() []string {
+	codes := make([]string, 0, len(currencies))
+
+	for code := range currencies {
+		codes = append(codes, code)
+	}
+
+	sortCurrencies(codes)
+
+	return codes
+}
+
+// SortByCurrency sorts amounts by currency in display order, keeping amounts in
+// the same currency in their original order
+func SortByCurrency(amounts []Money) {
+	ranks := currencyRanks()
+
+	sort.SliceStable(amounts, func(i, j int) bool {
+		return currencyBefore(ranks, amounts[i].currency, amounts[j].currency)
+	})
+}
+
+// SortedTotals returns the totals, e.g. of TotalByCurrency, ordered by currency
+// in display order so that output built from them is reproducible
+func SortedTotals(totals map[string]Money) []Money {
+	sorted := make([]Money, 0, len(totals))
+
+	for _, m := range totals {
+		sorted = append(sorted, m)
+	}
+
+	SortByCurrency(sorted)
+
+	return sorted
+}
+
+// FormatTotals formats the totals ordered by currency in display order, joined by separator,
+// e.g. "€45.00, $75.00"
+func FormatTotals(totals map[string]Money, separator string, opts ...Options) string {
+	noteDeprecated(opts...)
+
+	formatted := make([]string, 0, len(totals))
+
+	for _, m := range SortedTotals(totals) {
+		formatted = append(formatted, m.Format(opts...))
+	}
+
+	return strings.Join(formatted, separator)
+}
+
+// SortedNumericCodes returns the numeric currency codes of totals, e.g. of
+// AggregateByCurrency, in ascending order
+func SortedNumericCodes(totals map[uint16]int64) []uint16 {
+	codes := make([]uint16, 0, len(totals))
+
+	for code := range totals {
+		codes = append(codes, code)
+	}
+
+	sort.Slice(codes, func(i, j int) bool { return codes[i] < codes[j] })
+
+	return codes
+}
+
+// sortCurrencies sorts currency codes in display order
+func sortCurrencies(codes []string) {
+	ranks := currencyRanks()
+
+	sort.Slice(codes, func(i, j int) bool {
+		return currencyBefore(ranks, codes[i], codes[j])
+	})
+}
+
+// currencyRanks returns the positions set by SetCurrencyDisplayOrder
+func currencyRanks() map[string]int {
+	displayOrder.RLock()
+	defer displayOrder.RUnlock()
+
+	return displayOrder.ranks
+}
+
+// displayOrderCodes returns the codes set with SetCurrencyDisplayOrder, in order
+func displayOrderCodes() []string {
+	ranks := currencyRanks()
+	codes := make([]string, len(ranks))
+
+	for code, i := range ranks {
+		codes[i] = code
+	}
+
+	return codes
+}
+
+// currencyBefore reports whether currency a is displayed before currency b
+func currencyBefore(ranks map[string]int, a, b string) bool {
+	i, ranked := ranks[a]
+	j, other := ranks[b]
+
+	if ranked || other {
+		return ranked && (!other || i < j)
+	}
+
+	return a < b
+}
@@ -0,0 +1,80 @@
+package money
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSortByCurrency(t *testing.T) {
+	amounts := []Money{{1, "USD"}, {2, "EUR"}, {3, "USD"}, {4, "CHF"}}
+	expected := []Money{{4, "CHF"}, {2, "EUR"}, {1, "USD"}, {3, "USD"}}
+
+	SortByCurrency(amounts)
+
+	if !reflect.DeepEqual(amounts, expected) {
+		t.Errorf("Expected %v but got %v", expected, amounts)
+	}
+}
+
+func TestFormatTotals(t *testing.T) {
+	totals := map[string]Money{"USD": {7500, "USD"}, "EUR": {4500, "EUR"}, "CHF": {1000, "CHF"}}
+	expected := "Fr10.00, €45,00, $75.00"
+
+	for i := 0; i < 10; i++ {
+		if result := FormatTotals(totals, ", "); result != expected {
+			t.Fatalf("Expected %s but got %s", expected, result)
+		}
+	}
+
+	if result := FormatTotals(nil, ", "); result != "" {
+		t.Errorf("Expected an empty string but got %s", result)
+	}
+}
+
+func TestSortedNumericCodes(t *testing.T) {
+	totals := map[uint16]int64{978: 1, 36: 2, 840: 3}
+	expected := []uint16{36, 840, 978}
+
+	if codes := SortedNumericCodes(totals); !reflect.DeepEqual(codes, expected) {
+		t.Errorf("Expected %v but got %v", expected, codes)
+	}
+}
+
+func TestSetCurrencyDisplayOrder(t *testing.T) {
+	if err := SetCurrencyDisplayOrder([]string{"usd", "EUR"}); err != nil {
+		t.Fatal(err)
+	}
+
+	defer SetCurrencyDisplayOrder(nil)
+
+	totals := map[string]Money{"USD": {7500, "USD"}, "EUR": {4500, "EUR"}, "CHF": {1000, "CHF"}, "AUD": {100, "AUD"}}
+	expected := "$75.00, €45,00, $1.00, Fr10.00"
+
+	if result := FormatTotals(totals, ", "); result != expected {
+		t.Errorf("Expected %s but got %s", expected, result)
+	}
+
+	if codes := CurrencyCodes(); codes[0] != "USD" || codes[1] != "EUR" || codes[2] >= codes[3] {
+		t.Errorf("Expected USD, EUR then the other currencies in code order but got %v", codes[:4])
+	}
+
+	for _, codes := range [][]string{{"XXX"}, {"EUR", "eur"}} {
+		if err := SetCurrencyDisplayOrder(codes); err == nil {
+			t.Errorf("Expected %v to be rejected", codes)
+		}
+	}
+}
+
+func TestCurrencyCodes(t *testing.T) {
+	codes := CurrencyCodes()
+
+	if len(codes) != len(currencies) {
+		t.Errorf("Expected %d codes but got %d", len(currencies), len(codes))
+	}
+
+	for i := 1; i < len(codes); i++ {
+		if codes[i-1] >= codes[i] {
+			t.Errorf("Expected %s before %s", codes[i], codes[i-1])
+		}
+	}
+}
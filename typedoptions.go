@@ -0,0 +1,181 @@
+package money
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// FormatOptions are the typed equivalent of the Options keys. Empty or nil
+// fields keep the defaults.
+type FormatOptions struct {
+	Currency string
+
+	// FormatName selects a formatter registered with RegisterFormat
+	FormatName string
+
+	WithCents              *bool
+	WithCurrency           *bool
+	WithSymbol             *bool
+	WithSymbolSpace        *bool
+	WithThousandsSeparator *bool
+}
+
+// legacyKeys maps the Options keys to the FormatOptions fields replacing them
+var legacyKeys = map[string]string{
+	"currency":                 "Currency",
+	"format":                   "FormatName",
+	"with_cents":               "WithCents",
+	"with_currency":            "WithCurrency",
+	"with_symbol":              "WithSymbol",
+	"with_symbol_space":        "WithSymbolSpace",
+	"with_thousands_separator": "WithThousandsSeparator",
+}
+
+// DeprecationNotice reports an Options key passed where FormatOptions can be used
+type DeprecationNotice struct {
+	Key         string
+	Replacement string
+
+	// Caller is the file and line of the call passing the key
+	Caller string
+}
+
+var deprecations struct {
+	sync.RWMutex
+	logger func(DeprecationNotice)
+}
+
+// SetDeprecationLogger installs logger to receive a notice for each Options key
+// passed to a function or method of the package, directly or in a struct such
+// as ScopeConfig, so the remaining call sites can be found while migrating to
+// FormatOptions. The options of FormatIntl and FormatRuby, which FormatOptions
+// does not replace, and those passed to TypedOptions are not reported. Passing
+// nil stops the notices. The keys keep working either way.
+func SetDeprecationLogger(logger func(DeprecationNotice)) error {
+	return unlessFrozen(func() error {
+		deprecations.Lock()
+		defer deprecations.Unlock()
+
+		deprecations.logger = logger
+
+		return nil
+	})
+}
+
+// TypedOptions maps opts onto FormatOptions, failing as SetDefaults does for
+// unknown keys and values of the wrong type
+func TypedOptions(opts Options) (FormatOptions, error) {
+	if err := validate(opts); err != nil {
+		return FormatOptions{}, err
+	}
+
+	flag := func(k string) *bool {
+		if v, ok := opts[k].(bool); ok {
+			return &v
+		}
+
+		return nil
+	}
+
+	code, _ := opts["currency"].(string)
+	format, _ := opts["format"].(string)
+
+	return FormatOptions{
+		Currency:               code,
+		FormatName:             format,
+		WithCents:              flag("with_cents"),
+		WithCurrency:           flag("with_currency"),
+		WithSymbol:             flag("with_symbol"),
+		WithSymbolSpace:        flag("with_symbol_space"),
+		WithThousandsSeparator: flag("with_thousands_separator"),
+	}, nil
+}
+
+// Options returns the Options holding the fields that are set, for functions
+// taking no FormatOptions
+func (o FormatOptions) Options() Options {
+	opts := Options{}
+
+	for k, v := range map[string]string{"currency": o.Currency, "format": o.FormatName} {
+		if v != "" {
+			opts[k] = v
+		}
+	}
+
+	flags := map[string]*bool{
+		"with_cents":               o.WithCents,
+		"with_currency":            o.WithCurrency,
+		"with_symbol":              o.WithSymbol,
+		"with_symbol_space":        o.WithSymbolSpace,
+		"with_thousands_separator": o.WithThousandsSeparator,
+	}
+
+	for k, v := range flags {
+		if v != nil {
+			opts[k] = *v
+		}
+	}
+
+	return opts
+}
+
+// Format is like Format with the options
+func (o FormatOptions) Format(val float64) string {
+	return Format(val, o.Options())
+}
+
+// FormatMoney is like Money.Format with the options
+func (o FormatOptions) FormatMoney(m Money) string {
+	return m.Format(o.Options())
+}
+
+// NewTemplate is like NewTemplate with the options
+func (o FormatOptions) NewTemplate() *Template {
+	return NewTemplate(o.Options())
+}
+
+// Parse is like Parse with the options
+func (o FormatOptions) Parse(s string) (Money, error) {
+	return Parse(s, o.Options())
+}
+
+// noteDeprecated sends the deprecation logger a notice for each key of opts,
+// unless the function passing them was called from within the package. Every
+// exported function taking Options calls it directly, so the call depth holds.
+func noteDeprecated(opts ...Options) {
+	deprecations.RLock()
+	logger := deprecations.logger
+	deprecations.RUnlock()
+
+	if logger == nil || len(opts) == 0 {
+		return
+	}
+
+	_, own, _, _ := runtime.Caller(0)
+	_, file, line, ok := runtime.Caller(2)
+
+	if !ok || filepath.Dir(file) == filepath.Dir(own) && !strings.HasSuffix(file, "_test.go") {
+		return
+	}
+
+	var keys []string
+	seen := map[string]bool{}
+
+	for _, o := range opts {
+		for k := range o {
+			if _, ok := legacyKeys[k]; ok && !seen[k] {
+				keys, seen[k] = append(keys, k), true
+			}
+		}
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		logger(DeprecationNotice{k, legacyKeys[k], fmt.Sprintf("%s:%d", file, line)})
+	}
+}
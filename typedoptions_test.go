@@ -0,0 +1,119 @@
+package money
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFormatOptions(t *testing.T) {
+	yes, no := true, false
+	o := FormatOptions{Currency: "EUR", WithCurrency: &yes, WithCents: &no}
+
+	if s := o.Format(1234.56); s != "€1.234 EUR" {
+		t.Errorf("Expected €1.234 EUR but got %s", s)
+	}
+
+	if s := o.FormatMoney(Money{123456, "USD"}); s != "$1,234 USD" {
+		t.Errorf("Expected $1,234 USD but got %s", s)
+	}
+
+	if s := o.NewTemplate().Format(Money{100, "EUR"}); s != "€1 EUR" {
+		t.Errorf("Expected €1 EUR but got %s", s)
+	}
+
+	if m, err := o.Parse("1.234,56"); err != nil || m != (Money{123456, "EUR"}) {
+		t.Errorf("Expected €1.234,56 but got %s (%v)", m, err)
+	}
+
+	if s := (FormatOptions{}).Format(10); s != "$10.00" {
+		t.Errorf("Expected $10.00 but got %s", s)
+	}
+}
+
+func TestTypedOptions(t *testing.T) {
+	opts := Options{"currency": "EUR", "with_cents": false, "with_symbol_space": true}
+	o, err := TypedOptions(opts)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if o.Currency != "EUR" || o.WithCents == nil || *o.WithCents || o.WithSymbol != nil {
+		t.Errorf("Expected EUR without cents but got %+v", o)
+	}
+
+	if result := o.Options(); !reflect.DeepEqual(result, opts) {
+		t.Errorf("Expected %v but got %v", opts, result)
+	}
+
+	for _, opts := range []Options{{"with_cents": "no"}, {"rounding": true}} {
+		if _, err := TypedOptions(opts); err == nil {
+			t.Errorf("Expected %v to be rejected", opts)
+		}
+	}
+}
+
+func TestSetDeprecationLogger(t *testing.T) {
+	var notices []DeprecationNotice
+
+	SetDeprecationLogger(func(n DeprecationNotice) { notices = append(notices, n) })
+	defer SetDeprecationLogger(nil)
+
+	Format(10, Options{"with_cents": false, "currency": "EUR"})
+	Money{100, "EUR"}.Format(Options{"with_symbol": false})
+	Parse("$1.00", Options{"currency": "USD"})
+
+	expected := []DeprecationNotice{
+		{"currency", "Currency", ""},
+		{"with_cents", "WithCents", ""},
+		{"with_symbol", "WithSymbol", ""},
+		{"currency", "Currency", ""},
+	}
+
+	if len(notices) != len(expected) {
+		t.Fatalf("Expected %d notices but got %v", len(expected), notices)
+	}
+
+	for i, n := range notices {
+		if n.Key != expected[i].Key || n.Replacement != expected[i].Replacement || !strings.Contains(n.Caller, "typedoptions_test.go:") {
+			t.Errorf("Expected %v but got %v", expected[i], n)
+		}
+	}
+
+	notices = nil
+	yes := true
+
+	FormatOptions{WithSymbolSpace: &yes}.Format(10)
+	FormatOptions{Currency: "EUR"}.NewTemplate().Format(Money{100, "EUR"})
+
+	if len(notices) != 0 {
+		t.Errorf("Expected no notices but got %v", notices)
+	}
+
+	batch, _ := NewBatchFromMinorUnits([]int64{100, 200}, "EUR")
+	scope, _ := NewScope(ScopeConfig{})
+	cache := NewParseCache(4)
+
+	entries := map[string]func(){
+		"Batch.Format":      func() { batch.Format(Options{"with_cents": false}) },
+		"NewScanner":        func() { NewScanner(strings.NewReader("$1.00"), '\n', Options{"currency": "USD"}) },
+		"NewCSVWriter":      func() { NewCSVWriter(io.Discard, ',', Options{"currency": "USD"}) },
+		"ParseCache.Parse":  func() { cache.Parse("$1.00", Options{"currency": "USD"}) },
+		"Scope.Format":      func() { scope.Format(1, Options{"with_cents": false}) },
+		"Scope.FormatMoney": func() { scope.FormatMoney(Money{100, "USD"}, Options{"with_cents": false}) },
+		"FormatPrint":       func() { FormatPrint(10, Options{"with_symbol_space": true}) },
+		"Defer":             func() { Defer(10, Options{"currency": "EUR"}).Format() },
+		"FormatTotals":      func() { FormatTotals(map[string]Money{"USD": {100, "USD"}}, ", ", Options{"with_cents": false}) },
+	}
+
+	for name, fn := range entries {
+		notices = nil
+		fn()
+
+		if len(notices) != 1 || !strings.Contains(notices[0].Caller, "typedoptions_test.go:") {
+			t.Errorf("Expected a notice from %s but got %v", name, notices)
+		}
+	}
+}
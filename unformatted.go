@@ -0,0 +1,49 @@
+package money
+
+// Unformatted is a serializable amount whose rendering is deferred until the
+// display options are known, e.g. at the end of a translation pipeline
+type Unformatted struct {
+	Amount   float64 `json:"amount"`
+	Currency string  `json:"currency"`
+	Options  Options `json:"options,omitempty"`
+}
+
+// Defer captures an amount and its options for later formatting
+func Defer(val float64, opts ...Options) Unformatted {
+	options := Options{}
+
+	if len(opts) > 0 {
+		noteDeprecated(opts[0])
+		options = override(options, opts[0])
+	}
+
+	currency := defaults()["currency"].(string)
+
+	if code, ok := options["currency"].(string); ok {
+		currency = code
+	}
+
+	delete(options, "currency")
+
+	return Unformatted{val, currency, options}
+}
+
+// Format renders the amount with its captured options, overridden by the display
+// options. The currency is part of the amount and is never overridden.
+func (u Unformatted) Format(display ...Options) string {
+	options := override(Options{}, u.Options)
+
+	if len(display) > 0 {
+		noteDeprecated(display[0])
+		options = override(options, display[0])
+	}
+
+	options["currency"] = u.Currency
+
+	return Format(u.Amount, options)
+}
+
+// String renders the amount with its captured options
+func (u Unformatted) String() string {
+	return u.Format()
+}
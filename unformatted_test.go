@@ -0,0 +1,57 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDefer(t *testing.T) {
+	u := Defer(1234.5, Options{"currency": "EUR", "with_currency": true})
+
+	if u.Amount != 1234.5 || u.Currency != "EUR" {
+		t.Errorf("Expected 1234.5 EUR but got %v %s", u.Amount, u.Currency)
+	}
+
+	if u.String() != "€1.234,50 EUR" {
+		t.Errorf("Expected €1.234,50 EUR but got %s", u)
+	}
+
+	if currency := Defer(10).String(); currency != "$10.00" {
+		t.Errorf("Expected default currency to be captured but got %s", currency)
+	}
+}
+
+func TestUnformattedFormat(t *testing.T) {
+	u := Defer(10, Options{"currency": "GBP", "with_currency": true})
+
+	currency := u.Format(Options{"with_cents": false, "currency": "USD"})
+	expected := "£10 GBP"
+
+	if currency != expected {
+		t.Errorf("Expected %s but got %s", expected, currency)
+	}
+}
+
+func TestUnformattedJSON(t *testing.T) {
+	data, err := json.Marshal(Defer(10, Options{"currency": "JPY", "with_symbol_space": true}))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"amount":10,"currency":"JPY","options":{"with_symbol_space":true}}`
+
+	if string(data) != expected {
+		t.Errorf("Expected %s but got %s", expected, data)
+	}
+
+	var u Unformatted
+
+	if err := json.Unmarshal(data, &u); err != nil {
+		t.Fatal(err)
+	}
+
+	if u.String() != "¥ 10" {
+		t.Errorf("Expected ¥ 10 but got %s", u)
+	}
+}
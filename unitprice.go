@@ -0,0 +1,194 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// MeasureUnit is a unit goods are sold by, for unit prices
+type MeasureUnit string
+
+// Units of measure for unit prices
+const (
+	Gram       MeasureUnit = "g"
+	Kilogram   MeasureUnit = "kg"
+	Milliliter MeasureUnit = "ml"
+	Centiliter MeasureUnit = "cl"
+	Liter      MeasureUnit = "l"
+	Centimeter MeasureUnit = "cm"
+	Meter      MeasureUnit = "m"
+	Piece      MeasureUnit = "piece"
+)
+
+// measureUnits maps units to the smallest unit of their dimension and how many
+// of it they hold
+var measureUnits = map[MeasureUnit]struct {
+	base  MeasureUnit
+	scale int64
+}{
+	Gram:       {Gram, 1},
+	Kilogram:   {Gram, 1000},
+	Milliliter: {Milliliter, 1},
+	Centiliter: {Milliliter, 10},
+	Liter:      {Milliliter, 1000},
+	Centimeter: {Centimeter, 1},
+	Meter:      {Centimeter, 100},
+	Piece:      {Piece, 1},
+}
+
+// referenceUnits are the units unit prices are given per by default, as the EU
+// price indication directive asks
+var referenceUnits = map[MeasureUnit]MeasureUnit{
+	Gram:       Kilogram,
+	Milliliter: Liter,
+	Centimeter: Meter,
+	Piece:      Piece,
+}
+
+// UnitPriceDigits is the number of decimal digits UnitPrice.Decimal keeps beyond
+// those of the currency
+const UnitPriceDigits = 2
+
+// UnitPrice is the price of a quantity of goods per unit of measure, such as
+// €3.99/kg, kept exactly so prices of different pack sizes compare correctly
+type UnitPrice struct {
+	currency string
+	minor    *big.Rat // minor units per base unit of the dimension
+	quantity *big.Rat
+	unit     MeasureUnit
+}
+
+// UnitPriceOf returns the unit price of quantity of unit sold for total, per
+// kilogram, liter, meter or piece, failing for negative totals and quantities
+// which are not positive decimals
+func UnitPriceOf(total Money, quantity string, unit MeasureUnit) (UnitPrice, error) {
+	if _, ok := currencies[total.currency]; !ok {
+		return UnitPrice{}, fmt.Errorf("%w %q", ErrUnknownCurrency, total.currency)
+	}
+
+	if total.IsNegative() {
+		return UnitPrice{}, fmt.Errorf("money: invalid unit price of %s", total)
+	}
+
+	q, err := measureQuantity(quantity, unit)
+
+	if err != nil {
+		return UnitPrice{}, err
+	}
+
+	m := measureUnits[unit]
+	p := UnitPrice{total.currency, new(big.Rat).SetInt64(total.amount), big.NewRat(1, 1), referenceUnits[m.base]}
+	p.minor.Quo(p.minor, q.Mul(q, big.NewRat(m.scale, 1)))
+
+	return p, nil
+}
+
+// measureQuantity parses quantity, failing for unknown units and quantities
+// which are not positive decimals
+func measureQuantity(quantity string, unit MeasureUnit) (*big.Rat, error) {
+	if _, ok := measureUnits[unit]; !ok {
+		return nil, fmt.Errorf("money: unknown unit of measure %q", unit)
+	}
+
+	q, err := parseFactor(quantity)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if q.Sign() <= 0 {
+		return nil, fmt.Errorf("money: invalid quantity %s %s", quantity, unit)
+	}
+
+	return q, nil
+}
+
+// Per returns the unit price per quantity of unit, e.g. "100" Gram, failing for
+// units of another dimension
+func (p UnitPrice) Per(quantity string, unit MeasureUnit) (UnitPrice, error) {
+	if p.minor == nil {
+		return UnitPrice{}, fmt.Errorf("money: invalid unit price")
+	}
+
+	q, err := measureQuantity(quantity, unit)
+
+	if err != nil {
+		return UnitPrice{}, err
+	}
+
+	if measureUnits[unit].base != measureUnits[p.unit].base {
+		return UnitPrice{}, fmt.Errorf("money: cannot price per %s what is priced per %s", unit, p.unit)
+	}
+
+	return UnitPrice{p.currency, p.minor, q, unit}, nil
+}
+
+// exact returns the price of the quantity the unit price is given per, in
+// minor units
+func (p UnitPrice) exact() *big.Rat {
+	r := new(big.Rat).Mul(p.minor, p.quantity)
+	return r.Mul(r, big.NewRat(measureUnits[p.unit].scale, 1))
+}
+
+// Money returns the unit price rounded half away from zero to minor units, as
+// shown on a shelf label
+func (p UnitPrice) Money() (Money, error) {
+	if p.minor == nil {
+		return Money{}, fmt.Errorf("money: invalid unit price")
+	}
+
+	units, ok := roundRat(p.exact())
+
+	if !ok {
+		return Money{}, fmt.Errorf("%w: unit price of %s", ErrOverflow, p.currency)
+	}
+
+	return Money{units, p.currency}, nil
+}
+
+// Decimal returns the unit price in major units with UnitPriceDigits more
+// decimal digits than the currency has, e.g. "0.3325" for €0.33/100 g
+func (p UnitPrice) Decimal() string {
+	if p.minor == nil {
+		return ""
+	}
+
+	c := currencies[p.currency]
+	r := new(big.Rat).Quo(p.exact(), big.NewRat(c.units(), 1))
+
+	return r.FloatString(c.exponent() + UnitPriceDigits)
+}
+
+// Compare returns -1, 0 or +1 as p is cheaper than, as expensive as or dearer
+// than o, failing when currencies or dimensions differ
+func (p UnitPrice) Compare(o UnitPrice) (int, error) {
+	if p.minor == nil || o.minor == nil {
+		return 0, fmt.Errorf("money: invalid unit price")
+	}
+
+	if err := (Money{currency: p.currency}).sameCurrency(Money{currency: o.currency}); err != nil {
+		return 0, err
+	}
+
+	if measureUnits[p.unit].base != measureUnits[o.unit].base {
+		return 0, fmt.Errorf("money: cannot compare prices per %s and per %s", p.unit, o.unit)
+	}
+
+	return p.minor.Cmp(o.minor), nil
+}
+
+// String returns the unit price as a shelf label, e.g. "€3.99/kg" or
+// "€0.33/100 g"
+func (p UnitPrice) String() string {
+	m, err := p.Money()
+
+	if err != nil {
+		return p.Decimal() + " " + p.currency
+	}
+
+	if p.quantity.Cmp(big.NewRat(1, 1)) == 0 {
+		return fmt.Sprintf("%s/%s", m, p.unit)
+	}
+
+	return fmt.Sprintf("%s/%s %s", m, p.quantity.RatString(), p.unit)
+}
@@ -0,0 +1,110 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestUnitPriceOf(t *testing.T) {
+	values := []struct {
+		total    Money
+		quantity string
+		unit     MeasureUnit
+		expected string
+		decimal  string
+	}{
+		{Money{266, "EUR"}, "800", Gram, "€3,33/kg", "3.3250"},
+		{Money{199, "EUR"}, "1.5", Liter, "€1,33/l", "1.3267"},
+		{Money{89, "EUR"}, "33", Centiliter, "€2,70/l", "2.6970"},
+		{Money{450, "USD"}, "6", Piece, "$0.75/piece", "0.7500"},
+		{Money{1200, "USD"}, "250", Centimeter, "$4.80/m", "4.8000"},
+		{Money{980, "JPY"}, "0.4", Kilogram, "¥2,450/kg", "2450.00"},
+	}
+
+	for _, v := range values {
+		p, err := UnitPriceOf(v.total, v.quantity, v.unit)
+
+		if err != nil || p.String() != v.expected || p.Decimal() != v.decimal {
+			t.Errorf("Expected %s (%s) but got %s (%s) %v", v.expected, v.decimal, p, p.Decimal(), err)
+		}
+	}
+}
+
+func TestUnitPricePer(t *testing.T) {
+	p, _ := UnitPriceOf(Money{266, "EUR"}, "800", Gram)
+	per, err := p.Per("100", Gram)
+
+	if err != nil || per.String() != "€0,33/100 g" || per.Decimal() != "0.3325" {
+		t.Errorf("Expected €0,33/100 g (0.3325) but got %s (%s) %v", per, per.Decimal(), err)
+	}
+
+	if m, err := per.Money(); err != nil || m != (Money{33, "EUR"}) {
+		t.Errorf("Expected €0,33 but got %s %v", m, err)
+	}
+
+	if _, err := p.Per("1", Liter); err == nil {
+		t.Error("Expected a price per liter of goods sold by weight to be rejected")
+	}
+
+	if _, err := p.Per("0", Gram); err == nil {
+		t.Error("Expected a zero quantity to be rejected")
+	}
+}
+
+func TestUnitPriceCompare(t *testing.T) {
+	small, _ := UnitPriceOf(Money{249, "EUR"}, "500", Gram)
+	large, _ := UnitPriceOf(Money{459, "EUR"}, "1", Kilogram)
+	per100, _ := large.Per("100", Gram)
+
+	values := []struct {
+		a, b     UnitPrice
+		expected int
+	}{
+		{small, large, +1},
+		{large, small, -1},
+		{large, per100, 0},
+	}
+
+	for _, v := range values {
+		if c, err := v.a.Compare(v.b); err != nil || c != v.expected {
+			t.Errorf("Expected %d comparing %s to %s but got %d %v", v.expected, v.a, v.b, c, err)
+		}
+	}
+
+	dollars, _ := UnitPriceOf(Money{459, "USD"}, "1", Kilogram)
+
+	if _, err := large.Compare(dollars); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+
+	liters, _ := UnitPriceOf(Money{459, "EUR"}, "1", Liter)
+
+	if _, err := large.Compare(liters); err == nil {
+		t.Error("Expected prices per kg and per l not to compare")
+	}
+}
+
+func TestUnitPriceOfWhenInvalid(t *testing.T) {
+	values := []struct {
+		total    Money
+		quantity string
+		unit     MeasureUnit
+	}{
+		{Money{-100, "EUR"}, "1", Kilogram},
+		{Money{100, "EUR"}, "0", Kilogram},
+		{Money{100, "EUR"}, "-2", Kilogram},
+		{Money{100, "EUR"}, "", Kilogram},
+		{Money{100, "EUR"}, "1", MeasureUnit("lb")},
+		{Money{100, "XXX"}, "1", Kilogram},
+	}
+
+	for _, v := range values {
+		if _, err := UnitPriceOf(v.total, v.quantity, v.unit); err == nil {
+			t.Errorf("Expected %s for %s %s to be rejected", v.total, v.quantity, v.unit)
+		}
+	}
+
+	if _, err := (UnitPrice{}).Money(); err == nil {
+		t.Error("Expected the zero UnitPrice to be rejected")
+	}
+}
@@ -0,0 +1,176 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+)
+
+// Wallet holds balances in several currencies. It is not safe for concurrent use.
+type Wallet struct {
+	balances map[string]Money
+}
+
+// NewWallet returns a Wallet holding amounts, added up by currency
+func NewWallet(amounts ...Money) (*Wallet, error) {
+	w := &Wallet{map[string]Money{}}
+
+	for _, m := range amounts {
+		if err := w.Add(m); err != nil {
+			return nil, err
+		}
+	}
+
+	return w, nil
+}
+
+// Add adds m to the balance in its currency
+func (w *Wallet) Add(m Money) error {
+	if _, ok := currencies[m.currency]; !ok {
+		return fmt.Errorf("%w %q", ErrUnknownCurrency, m.currency)
+	}
+
+	balance, err := w.Balance(m.currency).Add(m)
+
+	if err != nil {
+		return err
+	}
+
+	w.balances[m.currency] = balance
+
+	return nil
+}
+
+// Subtract subtracts m from the balance in its currency, which may go negative
+func (w *Wallet) Subtract(m Money) error {
+	if _, ok := currencies[m.currency]; !ok {
+		return fmt.Errorf("%w %q", ErrUnknownCurrency, m.currency)
+	}
+
+	balance, err := w.Balance(m.currency).Subtract(m)
+
+	if err != nil {
+		return err
+	}
+
+	w.balances[m.currency] = balance
+
+	return nil
+}
+
+// Balance returns the balance in the currency code, zero when the wallet holds none
+func (w *Wallet) Balance(code string) Money {
+	code = strings.ToUpper(code)
+
+	if m, ok := w.balances[code]; ok {
+		return m
+	}
+
+	return Money{0, code}
+}
+
+// Balances returns the balances which are not zero, ordered by currency in
+// display order
+func (w *Wallet) Balances() []Money {
+	balances := make([]Money, 0, len(w.balances))
+
+	for _, m := range w.balances {
+		if m.amount != 0 {
+			balances = append(balances, m)
+		}
+	}
+
+	SortByCurrency(balances)
+
+	return balances
+}
+
+// CurrencyExposure is the exposure of a wallet to one currency
+type CurrencyExposure struct {
+	// Amount is the balance in the currency
+	Amount Money
+
+	// Value is the balance converted to the base currency
+	Value Money
+
+	// Weight is the share of the gross exposure in percent, negative for a
+	// negative balance
+	Weight float64
+}
+
+// ExposureReport is the currency exposure of a wallet in a base currency
+type ExposureReport struct {
+	// Currencies are the exposures by decreasing size of their value
+	Currencies []CurrencyExposure
+
+	// Net is the sum of the values
+	Net Money
+
+	// Gross is the sum of the sizes of the values, which weights are shares of
+	Gross Money
+}
+
+// Exposure returns the exposure of the wallet to each currency it holds, its
+// balances converted to the base currency by converter
+func (w *Wallet) Exposure(base string, converter Converter) (ExposureReport, error) {
+	base = strings.ToUpper(base)
+
+	if _, ok := currencies[base]; !ok {
+		return ExposureReport{}, fmt.Errorf("%w %q", ErrUnknownCurrency, base)
+	}
+
+	report := ExposureReport{Net: Money{0, base}, Gross: Money{0, base}}
+
+	for _, m := range w.Balances() {
+		value := m
+
+		if m.currency != base {
+			if converter == nil {
+				return ExposureReport{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.currency, base)
+			}
+
+			var err error
+
+			if value, err = converter.ConvertMoney(m, base); err != nil {
+				return ExposureReport{}, err
+			}
+
+			if err = value.sameCurrency(report.Net); err != nil {
+				return ExposureReport{}, err
+			}
+		}
+
+		net, err := report.Net.Add(value)
+
+		if err != nil {
+			return ExposureReport{}, err
+		}
+
+		gross, err := report.Gross.Add(value)
+
+		if value.IsNegative() {
+			gross, err = report.Gross.Subtract(value)
+		}
+
+		if err != nil {
+			return ExposureReport{}, err
+		}
+
+		report.Net, report.Gross = net, gross
+		report.Currencies = append(report.Currencies, CurrencyExposure{Amount: m, Value: value})
+	}
+
+	for i, e := range report.Currencies {
+		if e.Value.amount != 0 {
+			weight := big.NewRat(e.Value.amount, report.Gross.amount)
+			report.Currencies[i].Weight, _ = weight.Mul(weight, big.NewRat(100, 1)).Float64()
+		}
+	}
+
+	sort.SliceStable(report.Currencies, func(i, j int) bool {
+		return absUnits(report.Currencies[i].Value.amount) > absUnits(report.Currencies[j].Value.amount)
+	})
+
+	return report, nil
+}
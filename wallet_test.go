@@ -0,0 +1,84 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestWallet(t *testing.T) {
+	w, err := NewWallet(Money{1000, "USD"}, Money{500, "EUR"}, Money{250, "USD"})
+
+	if err != nil {
+		t.Fatalf("Expected no error but got %v", err)
+	}
+
+	if err := w.Subtract(Money{500, "EUR"}); err != nil {
+		t.Errorf("Expected no error but got %v", err)
+	}
+
+	if err := w.Subtract(Money{300, "GBP"}); err != nil {
+		t.Errorf("Expected no error but got %v", err)
+	}
+
+	if b := w.Balance("usd"); b != (Money{1250, "USD"}) {
+		t.Errorf("Expected $12.50 but got %s", b)
+	}
+
+	balances := w.Balances()
+
+	if len(balances) != 2 || balances[0].currency == "EUR" || balances[1].currency == "EUR" {
+		t.Errorf("Expected the USD and GBP balances only but got %v", balances)
+	}
+
+	if err := w.Add(Money{math.MaxInt64, "USD"}); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow but got %v", err)
+	}
+
+	if err := w.Add(Money{100, "XXX"}); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+}
+
+func TestWalletExposure(t *testing.T) {
+	e, _ := NewExchange("USD", nil)
+	e.SetRate("EUR", "USD", 1.1)
+	e.SetRate("GBP", "USD", 1.25)
+
+	w, _ := NewWallet(Money{60000, "USD"}, Money{20000, "EUR"}, Money{-8000, "GBP"})
+	report, err := w.Exposure("usd", e)
+
+	if err != nil {
+		t.Fatalf("Expected no error but got %v", err)
+	}
+
+	expected := []CurrencyExposure{
+		{Money{60000, "USD"}, Money{60000, "USD"}, 65.217391},
+		{Money{20000, "EUR"}, Money{22000, "USD"}, 23.913043},
+		{Money{-8000, "GBP"}, Money{-10000, "USD"}, -10.869565},
+	}
+
+	if len(report.Currencies) != len(expected) {
+		t.Fatalf("Expected %d currencies but got %v", len(expected), report.Currencies)
+	}
+
+	for i, v := range expected {
+		got := report.Currencies[i]
+
+		if got.Amount != v.Amount || got.Value != v.Value || math.Abs(got.Weight-v.Weight) > 0.000001 {
+			t.Errorf("Expected %v but got %v", v, got)
+		}
+	}
+
+	if report.Net != (Money{72000, "USD"}) || report.Gross != (Money{92000, "USD"}) {
+		t.Errorf("Expected a net $720.00 and gross $920.00 but got %s %s", report.Net, report.Gross)
+	}
+
+	if _, err := w.Exposure("USD", nil); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+
+	if _, err := w.Exposure("XXX", e); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+}
@@ -0,0 +1,43 @@
+package money
+
+import (
+	"unicode"
+)
+
+// wide holds the East Asian wide and fullwidth ranges of Unicode Standard Annex #11
+var wide = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x1100, 0x115f, 1},
+		{0x2e80, 0x303e, 1},
+		{0x3041, 0x33ff, 1},
+		{0x3400, 0x4dbf, 1},
+		{0x4e00, 0x9fff, 1},
+		{0xa000, 0xa4cf, 1},
+		{0xac00, 0xd7a3, 1},
+		{0xf900, 0xfaff, 1},
+		{0xfe30, 0xfe4f, 1},
+		{0xff00, 0xff60, 1},
+		{0xffe0, 0xffe6, 1},
+	},
+	R32: []unicode.Range32{
+		{0x20000, 0x3fffd, 1},
+	},
+}
+
+// DisplayWidth returns the number of terminal columns a formatted string takes.
+// Combining marks and format characters take none, East Asian wide and fullwidth
+// characters such as 円 or ￥ take two, anything else takes one. The ¥ and ₩ signs
+// are narrow characters and take one.
+func DisplayWidth(s string) (width int) {
+	for _, r := range s {
+		switch {
+		case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		case unicode.Is(wide, r):
+			width += 2
+		default:
+			width++
+		}
+	}
+
+	return width
+}
@@ -0,0 +1,24 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestDisplayWidth(t *testing.T) {
+	values := map[string]int{
+		"":          0,
+		"$1,000.00": 9,
+		"¥1,000":    6,
+		"₩1,000":    6,
+		"￥1,000":    7,
+		"1,000円":    7,
+		"B⃦1.00":    5,
+		"₩\u200d1":  2,
+	}
+
+	for value, expected := range values {
+		if w := DisplayWidth(value); w != expected {
+			t.Errorf("Expected %q to be %d wide but got %d", value, expected, w)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// Withholding is a gross payout split into the net paid and the tax withheld,
+// which always add up to the gross
+type Withholding struct {
+	Gross    Money
+	Net      Money
+	Withheld Money
+}
+
+// Withhold splits gross into the net paid and the tax withheld at rate, e.g.
+// 0.24 for 24%, rounded half away from zero so that net + withheld == gross. It
+// fails for rates outside [0, 1].
+func Withhold(gross Money, rate float64) (net, withheld Money, err error) {
+	if err := checkWithholdingRate(rate); err != nil {
+		return Money{}, Money{}, err
+	}
+
+	if _, ok := currencies[gross.currency]; !ok {
+		return Money{}, Money{}, fmt.Errorf("%w %q", ErrUnknownCurrency, gross.currency)
+	}
+
+	if withheld, err = gross.mulRate(rate); err != nil {
+		return Money{}, Money{}, err
+	}
+
+	return Money{gross.amount - withheld.amount, gross.currency}, withheld, nil
+}
+
+// WithholdBatch splits each payout of a batch as Withhold does, except that the
+// amounts withheld add up to the tax withheld on the total of the batch, as it is
+// reported, the minor units rounding leaves going to the payouts with the
+// largest fractions. It fails for mixed currencies and rates outside [0, 1].
+func WithholdBatch(gross []Money, rate float64) ([]Withholding, error) {
+	if err := checkWithholdingRate(rate); err != nil {
+		return nil, err
+	}
+
+	if len(gross) == 0 {
+		return nil, nil
+	}
+
+	r := decimalRat(rate)
+	exact := make([]*big.Rat, len(gross))
+	sum := new(big.Rat)
+
+	for i, g := range gross {
+		if err := gross[0].sameCurrency(g); err != nil {
+			return nil, err
+		}
+
+		if _, ok := currencies[g.currency]; !ok {
+			return nil, fmt.Errorf("%w %q", ErrUnknownCurrency, g.currency)
+		}
+
+		exact[i] = new(big.Rat).Mul(new(big.Rat).SetInt64(g.amount), r)
+		sum.Add(sum, exact[i])
+	}
+
+	withheld, ok := roundRat(sum)
+
+	if !ok {
+		return nil, fmt.Errorf("%w: withholding on %d payouts in %s", ErrOverflow, len(gross), gross[0].currency)
+	}
+
+	batch := make([]Withholding, len(gross))
+
+	for i, units := range largestRemainder(exact, withheld) {
+		g := gross[i]
+		batch[i] = Withholding{g, Money{g.amount - units, g.currency}, Money{units, g.currency}}
+	}
+
+	return batch, nil
+}
+
+// checkWithholdingRate fails for rates outside [0, 1]
+func checkWithholdingRate(rate float64) error {
+	if !(rate >= 0 && rate <= 1) || math.IsInf(rate, 0) {
+		return fmt.Errorf("money: invalid withholding rate %v", rate)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestWithhold(t *testing.T) {
+	values := []struct {
+		gross         Money
+		rate          float64
+		net, withheld Money
+	}{
+		{Money{10000, "USD"}, 0.24, Money{7600, "USD"}, Money{2400, "USD"}},
+		{Money{333, "USD"}, 0.3, Money{233, "USD"}, Money{100, "USD"}},
+		{Money{1005, "EUR"}, 0.1, Money{904, "EUR"}, Money{101, "EUR"}},
+		{Money{-1005, "EUR"}, 0.1, Money{-904, "EUR"}, Money{-101, "EUR"}},
+		{Money{999, "JPY"}, 0, Money{999, "JPY"}, Money{0, "JPY"}},
+		{Money{999, "JPY"}, 1, Money{0, "JPY"}, Money{999, "JPY"}},
+	}
+
+	for _, v := range values {
+		net, withheld, err := Withhold(v.gross, v.rate)
+
+		if err != nil || net != v.net || withheld != v.withheld {
+			t.Errorf("Expected %s and %s withheld from %s but got %s %s %v", v.net, v.withheld, v.gross, net, withheld, err)
+		}
+	}
+}
+
+func TestWithholdBatch(t *testing.T) {
+	gross := []Money{{1005, "EUR"}, {1005, "EUR"}, {1005, "EUR"}, {1007, "EUR"}}
+	batch, err := WithholdBatch(gross, 0.1)
+
+	if err != nil {
+		t.Fatalf("Expected no error but got %v", err)
+	}
+
+	// 100.5 + 100.5 + 100.5 + 100.7 = 402.2 rounds to 402, the two units left
+	// going to the largest fraction and the first of the ties
+	expected := []int64{101, 100, 100, 101}
+	var total int64
+
+	for i, w := range batch {
+		if w.Gross != gross[i] || w.Withheld != (Money{expected[i], "EUR"}) {
+			t.Errorf("Expected %d withheld from %s but got %v", expected[i], gross[i], w)
+		}
+
+		if w.Net.amount+w.Withheld.amount != w.Gross.amount {
+			t.Errorf("Expected %v to add up to its gross", w)
+		}
+
+		total += w.Withheld.amount
+	}
+
+	if total != 402 {
+		t.Errorf("Expected 402 withheld in total but got %d", total)
+	}
+
+	if batch, err := WithholdBatch(nil, 0.1); err != nil || batch != nil {
+		t.Errorf("Expected an empty batch but got %v %v", batch, err)
+	}
+}
+
+func TestWithholdWhenInvalid(t *testing.T) {
+	for _, rate := range []float64{-0.1, 1.5, math.NaN(), math.Inf(1)} {
+		if _, _, err := Withhold(Money{100, "USD"}, rate); err == nil {
+			t.Errorf("Expected a rate of %v to be rejected", rate)
+		}
+
+		if _, err := WithholdBatch([]Money{{100, "USD"}}, rate); err == nil {
+			t.Errorf("Expected a batch rate of %v to be rejected", rate)
+		}
+	}
+
+	if _, _, err := Withhold(Money{100, "XXX"}, 0.1); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency but got %v", err)
+	}
+
+	if _, err := WithholdBatch([]Money{{100, "USD"}, {100, "EUR"}}, 0.1); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch but got %v", err)
+	}
+}